@@ -30,6 +30,8 @@ var allowedAnnotations = sets.New[string](
 	DisableAutoTLSAnnotationKey,
 	DisableExternalDomainTLSAnnotationKey,
 	HTTPOptionAnnotationKey,
+	AccessLogAnnotationKey,
+	TraceSamplingRateAnnotationKey,
 
 	IngressClassAnnotationAltKey,
 	CertificateClassAnnotationAltKey,
@@ -61,6 +61,12 @@ func TestValidateObjectMetadata(t *testing.T) {
 			HTTPOptionAnnotationKey:   "Redirected",
 			HTTPProtocolAnnotationKey: "Redirected",
 		},
+	}, {
+		name: "valid observability annotation keys",
+		annotations: map[string]string{
+			AccessLogAnnotationKey:         "enabled",
+			TraceSamplingRateAnnotationKey: "0.1",
+		},
 	}, {
 		name: "valid ingress class annotation key",
 		annotations: map[string]string{
@@ -89,6 +89,15 @@ const (
 	// to indicate that external-domain-tls should not be enabled for it.
 	DisableExternalDomainTLSAnnotationKey = PublicGroupName + "/disable-external-domain-tls"
 
+	// AccessLogAnnotationKey is the annotation key attached to a Knative Service/Route
+	// to enable or disable the access logs of its Ingress, with the values
+	// "enabled" or "disabled".
+	AccessLogAnnotationKey = PublicGroupName + "/access-log"
+
+	// TraceSamplingRateAnnotationKey is the annotation key attached to a Knative
+	// Service/Route to set the fraction of the requests to its Ingress to trace.
+	TraceSamplingRateAnnotationKey = PublicGroupName + "/trace-sampling-rate"
+
 	// HTTPOptionAnnotationKey is the annotation key attached to a Knative Service/DomainMapping
 	// to indicate the HTTP option of it.
 	HTTPOptionAnnotationKey = PublicGroupName + "/httpOption"
@@ -126,6 +135,8 @@ const (
 	// and KServices.  It can be an annotation too but since users are
 	// already using labels for domain, it probably best to keep this
 	// consistent.
+	// Recognized values are VisibilityClusterLocal and VisibilityInternal,
+	// any other value denotes public visibility.
 	VisibilityLabelKey = PublicGroupName + "/visibility"
 
 	// CertificateTypeLabelKey is the label to indicate the type of Knative certificate
@@ -138,6 +149,17 @@ const (
 	TrustBundleLabelKey = PublicGroupName + "/trust-bundle"
 )
 
+const (
+	// VisibilityClusterLocal is the value of VisibilityLabelKey denoting that
+	// the resource should only be reachable from within the cluster.
+	VisibilityClusterLocal = "cluster-local"
+
+	// VisibilityInternal is the value of VisibilityLabelKey denoting that
+	// the resource should be reachable from the internal network (e.g. a VPC)
+	// but not from the internet.
+	VisibilityInternal = "internal"
+)
+
 // Pseudo-constants
 var (
 	// DefaultRetryCount will be set if Attempts not specified.
@@ -188,3 +210,13 @@ var GetDisableAutoTLS = GetDisableExternalDomainTLS
 func GetDisableExternalDomainTLS(annotations map[string]string) (val string) {
 	return DisableExternalDomainTLSAnnotation.Value(annotations)
 }
+
+// GetAccessLog returns the value of the access log annotation.
+func GetAccessLog(annotations map[string]string) (val string) {
+	return annotations[AccessLogAnnotationKey]
+}
+
+// GetTraceSamplingRate returns the value of the trace sampling rate annotation.
+func GetTraceSamplingRate(annotations map[string]string) (val string) {
+	return annotations[TraceSamplingRateAnnotationKey]
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Permits returns true if the BackendGrant allows an Ingress in the namespace
+// `from` to route traffic to the given backend.
+// Backends outside of the BackendGrant's namespace are never permitted.
+func (g *BackendGrant) Permits(from string, backend IngressBackend) bool {
+	if backend.ServiceNamespace != g.Namespace {
+		return false
+	}
+
+	fromMatches := false
+	for _, f := range g.Spec.From {
+		if f.Namespace == from {
+			fromMatches = true
+			break
+		}
+	}
+	if !fromMatches {
+		return false
+	}
+
+	for _, t := range g.Spec.To {
+		if t.ServiceName == "" || t.ServiceName == backend.ServiceName {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBackendPermitted returns true if an Ingress in the namespace `from` may route
+// traffic to the given backend. Backends in the same namespace are always permitted,
+// backends in other namespaces require at least one of the provided BackendGrants
+// to permit the reference.
+func IsBackendPermitted(from string, backend IngressBackend, grants []*BackendGrant) bool {
+	if backend.ServiceNamespace == from {
+		return true
+	}
+	for _, g := range grants {
+		if g.Permits(from, backend) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestIsBackendPermitted(t *testing.T) {
+	authGrant := &BackendGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "platform",
+			Name:      "auth",
+		},
+		Spec: BackendGrantSpec{
+			From: []BackendGrantFrom{{Namespace: "tenant-a"}},
+			To:   []BackendGrantTo{{ServiceName: "auth"}},
+		},
+	}
+	wildcardGrant := &BackendGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "platform",
+			Name:      "everything",
+		},
+		Spec: BackendGrantSpec{
+			From: []BackendGrantFrom{{Namespace: "tenant-b"}},
+			To:   []BackendGrantTo{{}},
+		},
+	}
+	backend := func(namespace, name string) IngressBackend {
+		return IngressBackend{
+			ServiceNamespace: namespace,
+			ServiceName:      name,
+			ServicePort:      intstr.FromInt(80),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		from    string
+		backend IngressBackend
+		grants  []*BackendGrant
+		want    bool
+	}{{
+		name:    "same namespace without grants",
+		from:    "tenant-a",
+		backend: backend("tenant-a", "app"),
+		want:    true,
+	}, {
+		name:    "other namespace without grants",
+		from:    "tenant-a",
+		backend: backend("platform", "auth"),
+		want:    false,
+	}, {
+		name:    "granted service",
+		from:    "tenant-a",
+		backend: backend("platform", "auth"),
+		grants:  []*BackendGrant{authGrant},
+		want:    true,
+	}, {
+		name:    "service not granted",
+		from:    "tenant-a",
+		backend: backend("platform", "assets"),
+		grants:  []*BackendGrant{authGrant},
+		want:    false,
+	}, {
+		name:    "namespace not granted",
+		from:    "tenant-c",
+		backend: backend("platform", "auth"),
+		grants:  []*BackendGrant{authGrant, wildcardGrant},
+		want:    false,
+	}, {
+		name:    "all services granted",
+		from:    "tenant-b",
+		backend: backend("platform", "assets"),
+		grants:  []*BackendGrant{authGrant, wildcardGrant},
+		want:    true,
+	}, {
+		name:    "grant from a different namespace than the backend",
+		from:    "tenant-b",
+		backend: backend("other", "assets"),
+		grants:  []*BackendGrant{wildcardGrant},
+		want:    false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsBackendPermitted(test.from, test.backend, test.grants); got != test.want {
+				t.Errorf("IsBackendPermitted() = %v, want: %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/kmeta"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackendGrant allows Ingresses from other namespaces to reference Services
+// in the BackendGrant's namespace as backends.
+//
+// This is modeled after the Gateway API ReferenceGrant: the grant lives in the
+// namespace of the referenced Services, so that their owners are in control of
+// who may route traffic to them.
+type BackendGrant struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the BackendGrant.
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Spec BackendGrantSpec `json:"spec,omitempty"`
+}
+
+// Verify that BackendGrant adheres to the appropriate interfaces.
+var (
+	// Check that BackendGrant may be validated and defaulted.
+	_ apis.Validatable = (*BackendGrant)(nil)
+	_ apis.Defaultable = (*BackendGrant)(nil)
+
+	// Check that we can create OwnerReferences to a BackendGrant.
+	_ kmeta.OwnerRefable = (*BackendGrant)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackendGrantList is a collection of BackendGrant objects.
+type BackendGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// More info: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of BackendGrant objects.
+	Items []BackendGrant `json:"items"`
+}
+
+// BackendGrantSpec describes which Ingresses may reference which Services.
+// A reference is permitted if the Ingress namespace matches one of the
+// entries in From and the Service matches one of the entries in To.
+type BackendGrantSpec struct {
+	// From is the list of namespaces whose Ingresses may reference
+	// Services in the namespace of this BackendGrant.
+	From []BackendGrantFrom `json:"from"`
+
+	// To is the list of Services in the namespace of this BackendGrant
+	// which may be referenced.
+	To []BackendGrantTo `json:"to"`
+}
+
+// BackendGrantFrom describes the namespace of Ingresses that are trusted
+// to reference Services.
+type BackendGrantFrom struct {
+	// Namespace is the namespace of the referencing Ingresses.
+	Namespace string `json:"namespace"`
+}
+
+// BackendGrantTo describes the Services that may be referenced.
+type BackendGrantTo struct {
+	// ServiceName is the name of the Service that may be referenced.
+	// If it is empty, all Services in the namespace may be referenced.
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+// Validate inspects and validates BackendGrant object.
+func (g *BackendGrant) Validate(ctx context.Context) *apis.FieldError {
+	return g.Spec.Validate(apis.WithinSpec(ctx)).ViaField("spec")
+}
+
+// Validate inspects and validates BackendGrantSpec object.
+func (spec *BackendGrantSpec) Validate(_ context.Context) (all *apis.FieldError) {
+	if len(spec.From) == 0 {
+		all = all.Also(apis.ErrMissingField("from"))
+	}
+	for i, from := range spec.From {
+		if from.Namespace == "" {
+			all = all.Also(apis.ErrMissingField("namespace").ViaFieldIndex("from", i))
+		} else if errs := validation.IsDNS1123Label(from.Namespace); len(errs) > 0 {
+			all = all.Also(apis.ErrInvalidValue(from.Namespace, "namespace").ViaFieldIndex("from", i))
+		}
+	}
+
+	if len(spec.To) == 0 {
+		all = all.Also(apis.ErrMissingField("to"))
+	}
+	for i, to := range spec.To {
+		if to.ServiceName == "" {
+			continue
+		}
+		if errs := validation.IsDNS1035Label(to.ServiceName); len(errs) > 0 {
+			all = all.Also(apis.ErrInvalidValue(to.ServiceName, "serviceName").ViaFieldIndex("to", i))
+		}
+	}
+	return all
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"knative.dev/pkg/apis"
+)
+
+func TestBackendGrantValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		bg   *BackendGrant
+		want *apis.FieldError
+	}{{
+		name: "valid",
+		bg: &BackendGrant{
+			Spec: BackendGrantSpec{
+				From: []BackendGrantFrom{{Namespace: "tenant"}},
+				To:   []BackendGrantTo{{ServiceName: "auth"}},
+			},
+		},
+		want: nil,
+	}, {
+		name: "valid-all-services",
+		bg: &BackendGrant{
+			Spec: BackendGrantSpec{
+				From: []BackendGrantFrom{{Namespace: "tenant-a"}, {Namespace: "tenant-b"}},
+				To:   []BackendGrantTo{{}},
+			},
+		},
+		want: nil,
+	}, {
+		name: "empty",
+		bg:   &BackendGrant{},
+		want: apis.ErrMissingField("spec.from", "spec.to"),
+	}, {
+		name: "missing-from-namespace",
+		bg: &BackendGrant{
+			Spec: BackendGrantSpec{
+				From: []BackendGrantFrom{{}},
+				To:   []BackendGrantTo{{}},
+			},
+		},
+		want: apis.ErrMissingField("spec.from[0].namespace"),
+	}, {
+		name: "invalid-from-namespace",
+		bg: &BackendGrant{
+			Spec: BackendGrantSpec{
+				From: []BackendGrantFrom{{Namespace: "Not_A_Namespace"}},
+				To:   []BackendGrantTo{{}},
+			},
+		},
+		want: apis.ErrInvalidValue("Not_A_Namespace", "spec.from[0].namespace"),
+	}, {
+		name: "invalid-service-name",
+		bg: &BackendGrant{
+			Spec: BackendGrantSpec{
+				From: []BackendGrantFrom{{Namespace: "tenant"}},
+				To:   []BackendGrantTo{{ServiceName: "auth"}, {ServiceName: "auth.default"}},
+			},
+		},
+		want: apis.ErrInvalidValue("auth.default", "spec.to[1].serviceName"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.bg.Validate(context.Background())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
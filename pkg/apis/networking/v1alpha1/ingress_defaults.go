@@ -35,6 +35,22 @@ func (is *IngressSpec) SetDefaults(ctx context.Context) {
 	for i := range is.Rules {
 		is.Rules[i].SetDefaults(ctx)
 	}
+	for i := range is.ErrorPages {
+		is.ErrorPages[i].SetDefaults(ctx)
+	}
+}
+
+// SetDefaults populates default values in ErrorPage
+func (e *ErrorPage) SetDefaults(ctx context.Context) {
+	if e.StatusCodes.To == 0 {
+		e.StatusCodes.To = e.StatusCodes.From
+	}
+	if e.Backend != nil && e.Backend.External != nil {
+		e.Backend.External.SetDefaults(ctx)
+	}
+	if e.Inline != nil && e.Inline.ContentType == "" {
+		e.Inline.ContentType = DefaultErrorPageContentType
+	}
 }
 
 // SetDefaults populates default values in IngressTLS
@@ -45,7 +61,21 @@ func (r *IngressRule) SetDefaults(ctx context.Context) {
 	if r.Visibility == "" {
 		r.Visibility = IngressVisibilityExternalIP
 	}
-	r.HTTP.SetDefaults(ctx)
+	if r.HTTP != nil {
+		r.HTTP.SetDefaults(ctx)
+	}
+	if r.TLSPassthrough != nil {
+		r.TLSPassthrough.SetDefaults(ctx)
+	}
+}
+
+// SetDefaults populates default values in TLSPassthroughRuleValue
+func (t *TLSPassthroughRuleValue) SetDefaults(_ context.Context) {
+	// Connections are forwarded as is, so external destinations
+	// are expected to serve TLS.
+	if t.Backend.External != nil && t.Backend.External.Port == 0 {
+		t.Backend.External.Port = DefaultHTTPSPort
+	}
 }
 
 // SetDefaults populates default values in HTTPIngressRuleValue
@@ -56,9 +86,29 @@ func (h *HTTPIngressRuleValue) SetDefaults(ctx context.Context) {
 }
 
 // SetDefaults populates default values in HTTPIngressPath
-func (h *HTTPIngressPath) SetDefaults(_ context.Context) {
+func (h *HTTPIngressPath) SetDefaults(ctx context.Context) {
 	// If only one split is specified, we default to 100.
 	if len(h.Splits) == 1 && h.Splits[0].Percent == 0 {
 		h.Splits[0].Percent = 100
 	}
+	for i := range h.Splits {
+		if h.Splits[i].External != nil {
+			h.Splits[i].External.SetDefaults(ctx)
+		}
+	}
+}
+
+// SetDefaults populates default values in ExternalBackend
+func (e *ExternalBackend) SetDefaults(_ context.Context) {
+	if e.Scheme == "" {
+		e.Scheme = "http"
+	}
+	if e.Port == 0 {
+		switch e.Scheme {
+		case "http":
+			e.Port = 80
+		case "https":
+			e.Port = 443
+		}
+	}
 }
@@ -136,6 +136,127 @@ func TestIngressDefaulting(t *testing.T) {
 				}},
 			},
 		},
+	}, {
+		name: "external-backend-defaulting",
+		in: &Ingress{
+			Spec: IngressSpec{
+				Rules: []IngressRule{{
+					HTTP: &HTTPIngressRuleValue{
+						Paths: []HTTPIngressPath{{
+							Splits: []IngressBackendSplit{{
+								IngressBackend: IngressBackend{
+									External: &ExternalBackend{Host: "api.example.com"},
+								},
+								Percent: 30,
+							}, {
+								IngressBackend: IngressBackend{
+									External: &ExternalBackend{
+										Host:   "api.example.com",
+										Scheme: "https",
+									},
+								},
+								Percent: 70,
+							}},
+						}},
+					},
+				}},
+			},
+		},
+		want: &Ingress{
+			Spec: IngressSpec{
+				Rules: []IngressRule{{
+					Visibility: IngressVisibilityExternalIP,
+					HTTP: &HTTPIngressRuleValue{
+						Paths: []HTTPIngressPath{{
+							Splits: []IngressBackendSplit{{
+								IngressBackend: IngressBackend{
+									External: &ExternalBackend{
+										Host:   "api.example.com",
+										Port:   80,
+										Scheme: "http",
+									},
+								},
+								Percent: 30,
+							}, {
+								IngressBackend: IngressBackend{
+									External: &ExternalBackend{
+										Host:   "api.example.com",
+										Port:   443,
+										Scheme: "https",
+									},
+								},
+								Percent: 70,
+							}},
+						}},
+					},
+				}},
+			},
+		},
+	}, {
+		name: "tls-passthrough-defaulting",
+		in: &Ingress{
+			Spec: IngressSpec{
+				Rules: []IngressRule{{
+					Hosts: []string{"db.example.com"},
+					TLSPassthrough: &TLSPassthroughRuleValue{
+						Backend: IngressBackend{
+							External: &ExternalBackend{Host: "db.example.org"},
+						},
+					},
+				}},
+			},
+		},
+		want: &Ingress{
+			Spec: IngressSpec{
+				Rules: []IngressRule{{
+					Hosts:      []string{"db.example.com"},
+					Visibility: IngressVisibilityExternalIP,
+					TLSPassthrough: &TLSPassthroughRuleValue{
+						Backend: IngressBackend{
+							External: &ExternalBackend{
+								Host: "db.example.org",
+								Port: 443,
+							},
+						},
+					},
+				}},
+			},
+		},
+	}, {
+		name: "error-pages-defaulting",
+		in: &Ingress{
+			Spec: IngressSpec{
+				ErrorPages: []ErrorPage{{
+					StatusCodes: StatusCodeRange{From: 503},
+					Inline:      &InlineErrorPage{Body: "Try again later."},
+				}, {
+					StatusCodes: StatusCodeRange{From: 500, To: 599},
+					Backend: &IngressBackend{
+						External: &ExternalBackend{Host: "errors.example.com"},
+					},
+				}},
+			},
+		},
+		want: &Ingress{
+			Spec: IngressSpec{
+				ErrorPages: []ErrorPage{{
+					StatusCodes: StatusCodeRange{From: 503, To: 503},
+					Inline: &InlineErrorPage{
+						Body:        "Try again later.",
+						ContentType: "text/plain; charset=utf-8",
+					},
+				}, {
+					StatusCodes: StatusCodeRange{From: 500, To: 599},
+					Backend: &IngressBackend{
+						External: &ExternalBackend{
+							Host:   "errors.example.com",
+							Port:   80,
+							Scheme: "http",
+						},
+					},
+				}},
+			},
+		},
 	}}
 
 	for _, test := range tests {
@@ -17,13 +17,34 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"net/netip"
 	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/networking/pkg/apis/networking"
+	"knative.dev/pkg/ptr"
 )
 
+// VisibilityFromLabels returns the IngressVisibility denoted by the
+// networking.VisibilityLabelKey label in the given labels. It defaults
+// to IngressVisibilityExternalIP if the label is absent or unknown.
+func VisibilityFromLabels(labels map[string]string) IngressVisibility {
+	switch labels[networking.VisibilityLabelKey] {
+	case networking.VisibilityClusterLocal:
+		return IngressVisibilityClusterLocal
+	case networking.VisibilityInternal:
+		return IngressVisibilityInternalIP
+	default:
+		return IngressVisibilityExternalIP
+	}
+}
+
 // GetIngressTLSForVisibility returns a list of `Spec.TLS` where each host in the `Rules.Hosts` field is
 // present in `Spec.TLS.Hosts` and where the Rules have the defined ingress visibility.
 // This method can be used in net-* implementations to select the correct `IngressTLS` entries
-// for cluster-local and cluster-external gateways/listeners.
+// for cluster-local, internal and cluster-external gateways/listeners.
 func (i *Ingress) GetIngressTLSForVisibility(visibility IngressVisibility) []IngressTLS {
 	ingressTLS := make([]IngressTLS, 0, len(i.Spec.TLS))
 
@@ -53,3 +74,98 @@ func (i *Ingress) GetIngressTLSForVisibility(visibility IngressVisibility) []Ing
 
 	return ingressTLS
 }
+
+// GetListenerPortsForVisibility returns the ports the listeners serving the rules with
+// the given visibility have to listen on, sorted by port number. It can be used in
+// net-* implementations to populate the Ports of LoadBalancerIngressStatus.
+func (i *Ingress) GetListenerPortsForVisibility(visibility IngressVisibility) []corev1.PortStatus {
+	ports := sets.New[int32]()
+	for _, rule := range i.Spec.Rules {
+		if rule.Visibility != visibility {
+			continue
+		}
+		switch {
+		case rule.Port != 0:
+			ports.Insert(rule.Port)
+		case rule.TLSPassthrough != nil:
+			ports.Insert(DefaultHTTPSPort)
+		default:
+			ports.Insert(DefaultHTTPPort)
+		}
+	}
+	for _, tls := range i.GetIngressTLSForVisibility(visibility) {
+		if tls.Port != 0 {
+			ports.Insert(tls.Port)
+		} else {
+			ports.Insert(DefaultHTTPSPort)
+		}
+	}
+
+	status := make([]corev1.PortStatus, 0, ports.Len())
+	for _, port := range sets.List(ports) {
+		status = append(status, corev1.PortStatus{
+			Port:     port,
+			Protocol: corev1.ProtocolTCP,
+		})
+	}
+	return status
+}
+
+// IPsForFamily returns the addresses of the load-balancer ingress point
+// belonging to the given IP family.
+func (s *LoadBalancerIngressStatus) IPsForFamily(family corev1.IPFamily) []string {
+	if family != corev1.IPv4Protocol && family != corev1.IPv6Protocol {
+		return nil
+	}
+	var ips []string
+	for _, ip := range s.IPs {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			continue
+		}
+		if is4 := addr.Unmap().Is4(); (family == corev1.IPv4Protocol) == is4 {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// HeaderValue returns the value of the Strict-Transport-Security header
+// advertising the policy.
+func (h *HSTSPolicy) HeaderValue() string {
+	value := "max-age=" + strconv.FormatInt(h.MaxAge, 10)
+	if h.IncludeSubDomains {
+		value += "; includeSubDomains"
+	}
+	if h.Preload {
+		value += "; preload"
+	}
+	return value
+}
+
+// PathOptions returns the request handling options of the given path of the rule,
+// falling back to the options of the rule for the ones the path doesn't set.
+func (h *HTTPIngressRuleValue) PathOptions(path *HTTPIngressPath) HTTPIngressRuleOptions {
+	var opts HTTPIngressRuleOptions
+	if h.Options != nil {
+		opts = *h.Options.DeepCopy()
+	}
+	if path.MaxRequestBodyBytes != nil {
+		opts.MaxRequestBodyBytes = ptr.Int64(*path.MaxRequestBodyBytes)
+	}
+	if path.RequestBuffering != "" {
+		opts.RequestBuffering = path.RequestBuffering
+	}
+	return opts
+}
+
+// ErrorPageFor returns the error page replacing the responses with the given
+// status code, or nil if there is none.
+func (is *IngressSpec) ErrorPageFor(code int) *ErrorPage {
+	for i := range is.ErrorPages {
+		if r := is.ErrorPages[i].StatusCodes; code >= r.From && code <= r.last() {
+			return &is.ErrorPages[i]
+		}
+	}
+	return nil
+}
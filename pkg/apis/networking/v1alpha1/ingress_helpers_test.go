@@ -17,9 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/networking/pkg/apis/networking"
+	"knative.dev/pkg/ptr"
 )
 
 var hosts = []string{"foo", "bar", "foo.bar"}
@@ -70,6 +74,28 @@ func TestGetIngressTLSForVisibility(t *testing.T) {
 			},
 		},
 		want: make([]IngressTLS, 0),
+	}, {
+		name:       "matching internal entries",
+		visibility: IngressVisibilityInternalIP,
+		ingress: &Ingress{
+			Spec: IngressSpec{
+				Rules: []IngressRule{
+					{
+						Hosts:      hosts,
+						Visibility: IngressVisibilityInternalIP,
+					},
+					{
+						Hosts:      []string{"other", "entries"},
+						Visibility: IngressVisibilityExternalIP,
+					},
+				},
+				TLS: []IngressTLS{
+					{Hosts: hosts},
+					{Hosts: []string{"other", "entries"}},
+				},
+			},
+		},
+		want: []IngressTLS{{Hosts: hosts}},
 	}, {
 		name:       "matching cluster-local entries",
 		visibility: IngressVisibilityClusterLocal,
@@ -168,3 +194,242 @@ func TestGetIngressTLSForVisibility(t *testing.T) {
 		})
 	}
 }
+
+func TestVisibilityFromLabels(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   IngressVisibility
+	}{{
+		name: "no labels",
+		want: IngressVisibilityExternalIP,
+	}, {
+		name:   "cluster-local",
+		labels: map[string]string{networking.VisibilityLabelKey: networking.VisibilityClusterLocal},
+		want:   IngressVisibilityClusterLocal,
+	}, {
+		name:   "internal",
+		labels: map[string]string{networking.VisibilityLabelKey: networking.VisibilityInternal},
+		want:   IngressVisibilityInternalIP,
+	}, {
+		name:   "unknown value",
+		labels: map[string]string{networking.VisibilityLabelKey: "public"},
+		want:   IngressVisibilityExternalIP,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := VisibilityFromLabels(test.labels); got != test.want {
+				t.Errorf("VisibilityFromLabels() = %q, want: %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIPsForFamily(t *testing.T) {
+	lb := &LoadBalancerIngressStatus{
+		IPs: []string{"10.0.0.1", "2001:db8::1", "not-an-ip", "10.0.0.2"},
+	}
+	tests := []struct {
+		family corev1.IPFamily
+		want   []string
+	}{{
+		family: corev1.IPv4Protocol,
+		want:   []string{"10.0.0.1", "10.0.0.2"},
+	}, {
+		family: corev1.IPv6Protocol,
+		want:   []string{"2001:db8::1"},
+	}, {
+		family: corev1.IPFamily("IPvX"),
+	}}
+
+	for _, test := range tests {
+		t.Run(string(test.family), func(t *testing.T) {
+			if got := lb.IPsForFamily(test.family); !cmp.Equal(got, test.want) {
+				t.Errorf("IPsForFamily (-want, +got) = \n%s", cmp.Diff(test.want, got))
+			}
+		})
+	}
+}
+
+func TestGetListenerPortsForVisibility(t *testing.T) {
+	ing := &Ingress{
+		Spec: IngressSpec{
+			Rules: []IngressRule{{
+				Hosts:      []string{"foo"},
+				Visibility: IngressVisibilityExternalIP,
+			}, {
+				Hosts:      []string{"bar"},
+				Visibility: IngressVisibilityExternalIP,
+				Port:       8080,
+			}, {
+				Hosts:      []string{"baz"},
+				Visibility: IngressVisibilityClusterLocal,
+				Port:       9000,
+			}, {
+				Hosts:          []string{"db"},
+				Visibility:     IngressVisibilityInternalIP,
+				TLSPassthrough: &TLSPassthroughRuleValue{},
+			}},
+			TLS: []IngressTLS{{
+				Hosts: []string{"foo"},
+			}, {
+				Hosts: []string{"bar"},
+				Port:  8443,
+			}},
+		},
+	}
+
+	tests := []struct {
+		visibility IngressVisibility
+		want       []corev1.PortStatus
+	}{{
+		visibility: IngressVisibilityExternalIP,
+		want: []corev1.PortStatus{
+			{Port: 80, Protocol: corev1.ProtocolTCP},
+			{Port: 443, Protocol: corev1.ProtocolTCP},
+			{Port: 8080, Protocol: corev1.ProtocolTCP},
+			{Port: 8443, Protocol: corev1.ProtocolTCP},
+		},
+	}, {
+		visibility: IngressVisibilityClusterLocal,
+		want: []corev1.PortStatus{
+			{Port: 9000, Protocol: corev1.ProtocolTCP},
+		},
+	}, {
+		visibility: IngressVisibilityInternalIP,
+		want: []corev1.PortStatus{
+			{Port: 443, Protocol: corev1.ProtocolTCP},
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(string(test.visibility), func(t *testing.T) {
+			if got := ing.GetListenerPortsForVisibility(test.visibility); !cmp.Equal(got, test.want) {
+				t.Errorf("GetListenerPortsForVisibility (-want, +got) = \n%s", cmp.Diff(test.want, got))
+			}
+		})
+	}
+}
+
+func TestHSTSHeaderValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *HSTSPolicy
+		want   string
+	}{{
+		name:   "max-age only",
+		policy: &HSTSPolicy{MaxAge: 300},
+		want:   "max-age=300",
+	}, {
+		name:   "forget",
+		policy: &HSTSPolicy{},
+		want:   "max-age=0",
+	}, {
+		name: "all directives",
+		policy: &HSTSPolicy{
+			MaxAge:            HSTSPreloadMinMaxAge,
+			IncludeSubDomains: true,
+			Preload:           true,
+		},
+		want: "max-age=31536000; includeSubDomains; preload",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.policy.HeaderValue(); got != test.want {
+				t.Errorf("HeaderValue() = %q, wanted %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPathOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *HTTPIngressRuleOptions
+		path    HTTPIngressPath
+		want    HTTPIngressRuleOptions
+	}{{
+		name: "none",
+	}, {
+		name: "rule options",
+		options: &HTTPIngressRuleOptions{
+			MaxRequestBodyBytes: ptr.Int64(1024),
+			RequestBuffering:    RequestBufferingBuffered,
+		},
+		want: HTTPIngressRuleOptions{
+			MaxRequestBodyBytes: ptr.Int64(1024),
+			RequestBuffering:    RequestBufferingBuffered,
+		},
+	}, {
+		name: "path overrides",
+		options: &HTTPIngressRuleOptions{
+			MaxRequestBodyBytes: ptr.Int64(1024),
+			RequestBuffering:    RequestBufferingBuffered,
+		},
+		path: HTTPIngressPath{
+			MaxRequestBodyBytes: ptr.Int64(2048),
+		},
+		want: HTTPIngressRuleOptions{
+			MaxRequestBodyBytes: ptr.Int64(2048),
+			RequestBuffering:    RequestBufferingBuffered,
+		},
+	}, {
+		name: "path only",
+		path: HTTPIngressPath{
+			RequestBuffering: RequestBufferingStreamed,
+		},
+		want: HTTPIngressRuleOptions{
+			RequestBuffering: RequestBufferingStreamed,
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := &HTTPIngressRuleValue{
+				Paths:   []HTTPIngressPath{test.path},
+				Options: test.options,
+			}
+			if got := rule.PathOptions(&rule.Paths[0]); !cmp.Equal(got, test.want) {
+				t.Errorf("PathOptions (-want, +got) = \n%s", cmp.Diff(test.want, got))
+			}
+		})
+	}
+}
+
+func TestErrorPageFor(t *testing.T) {
+	spec := &IngressSpec{
+		ErrorPages: []ErrorPage{{
+			StatusCodes: StatusCodeRange{From: 503},
+			Inline:      &InlineErrorPage{Body: "unavailable"},
+		}, {
+			StatusCodes: StatusCodeRange{From: 500, To: 599},
+			Inline:      &InlineErrorPage{Body: "server error"},
+		}},
+	}
+
+	tests := []struct {
+		code int
+		want *ErrorPage
+	}{{
+		code: 503,
+		want: &spec.ErrorPages[0],
+	}, {
+		code: 504,
+		want: &spec.ErrorPages[1],
+	}, {
+		code: 500,
+		want: &spec.ErrorPages[1],
+	}, {
+		code: 404,
+	}}
+
+	for _, test := range tests {
+		t.Run(strconv.Itoa(test.code), func(t *testing.T) {
+			if got := spec.ErrorPageFor(test.code); got != test.want {
+				t.Errorf("ErrorPageFor(%d) = %v, wanted %v", test.code, got, test.want)
+			}
+		})
+	}
+}
@@ -18,16 +18,21 @@ package v1alpha1
 
 import (
 	"fmt"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 var ingressCondSet = apis.NewLivingConditionSet(
 	IngressConditionNetworkConfigured,
 	IngressConditionLoadBalancerReady,
+	IngressConditionTLSConfigured,
 )
 
+var ruleCondSet = apis.NewLivingConditionSet()
+
 // GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
 func (*Ingress) GetConditionSet() apis.ConditionSet {
 	return ingressCondSet
@@ -62,13 +67,22 @@ func (is *IngressStatus) MarkResourceNotOwned(kind, name string) {
 
 // MarkLoadBalancerReady marks the Ingress with IngressConditionLoadBalancerReady,
 // and also populate the address of the load balancer.
+// The IP and IPs fields of the load balancer ingress points are kept consistent,
+// so that consumers can rely on either of them.
 func (is *IngressStatus) MarkLoadBalancerReady(publicLbs []LoadBalancerIngressStatus, privateLbs []LoadBalancerIngressStatus) {
-	is.PublicLoadBalancer = &LoadBalancerStatus{Ingress: publicLbs}
-	is.PrivateLoadBalancer = &LoadBalancerStatus{Ingress: privateLbs}
+	is.PublicLoadBalancer = &LoadBalancerStatus{Ingress: normalizeLoadBalancerIngresses(publicLbs)}
+	is.PrivateLoadBalancer = &LoadBalancerStatus{Ingress: normalizeLoadBalancerIngresses(privateLbs)}
 
 	ingressCondSet.Manage(is).MarkTrue(IngressConditionLoadBalancerReady)
 }
 
+// MarkLoadBalancerReadyWithInternal marks the Ingress with IngressConditionLoadBalancerReady,
+// and also populates the addresses of the public, private and internal load balancers.
+func (is *IngressStatus) MarkLoadBalancerReadyWithInternal(publicLbs, privateLbs, internalLbs []LoadBalancerIngressStatus) {
+	is.InternalLoadBalancer = &LoadBalancerStatus{Ingress: normalizeLoadBalancerIngresses(internalLbs)}
+	is.MarkLoadBalancerReady(publicLbs, privateLbs)
+}
+
 // MarkLoadBalancerNotReady marks the "IngressConditionLoadBalancerReady" condition to unknown to
 // reflect that the load balancer is not ready yet.
 func (is *IngressStatus) MarkLoadBalancerNotReady() {
@@ -81,6 +95,23 @@ func (is *IngressStatus) MarkLoadBalancerFailed(reason, message string) {
 	ingressCondSet.Manage(is).MarkFalse(IngressConditionLoadBalancerReady, reason, message)
 }
 
+// MarkTLSConfigured marks the "IngressConditionTLSConfigured" condition to true.
+func (is *IngressStatus) MarkTLSConfigured() {
+	ingressCondSet.Manage(is).MarkTrue(IngressConditionTLSConfigured)
+}
+
+// MarkTLSNotConfigured marks the "IngressConditionTLSConfigured" condition to unknown to
+// reflect that the certificates are not configured yet.
+func (is *IngressStatus) MarkTLSNotConfigured(reason, message string) {
+	ingressCondSet.Manage(is).MarkUnknown(IngressConditionTLSConfigured, reason, message)
+}
+
+// MarkTLSConfigurationFailed marks the "IngressConditionTLSConfigured" condition to false
+// to reflect that the certificates are invalid, e.g. missing, expired or not covering the hosts.
+func (is *IngressStatus) MarkTLSConfigurationFailed(reason, message string) {
+	ingressCondSet.Manage(is).MarkFalse(IngressConditionTLSConfigured, reason, message)
+}
+
 // MarkIngressNotReady marks the "IngressConditionReady" condition to unknown.
 func (is *IngressStatus) MarkIngressNotReady(reason, message string) {
 	ingressCondSet.Manage(is).MarkUnknown(IngressConditionReady, reason, message)
@@ -93,3 +124,83 @@ func (i *Ingress) IsReady() bool {
 	return is.ObservedGeneration == i.Generation &&
 		is.GetCondition(IngressConditionReady).IsTrue()
 }
+
+// GetRuleStatus returns the status of the given host, or nil if there is none.
+func (is *IngressStatus) GetRuleStatus(host string) *RuleStatus {
+	for i := range is.Rules {
+		if is.Rules[i].Host == host {
+			return &is.Rules[i]
+		}
+	}
+	return nil
+}
+
+// ruleStatus returns the status of the given host, adding it if it's missing.
+func (is *IngressStatus) ruleStatus(host string) *RuleStatus {
+	if rs := is.GetRuleStatus(host); rs != nil {
+		return rs
+	}
+	is.Rules = append(is.Rules, RuleStatus{Host: host})
+	return &is.Rules[len(is.Rules)-1]
+}
+
+// MarkHostReady marks the given host as ready and records the URL
+// at which it has been observed to be served.
+func (is *IngressStatus) MarkHostReady(host string, url *apis.URL) {
+	rs := is.ruleStatus(host)
+	rs.URL = url
+	ruleCondSet.Manage(rs).MarkTrue(RuleConditionReady)
+}
+
+// MarkHostNotReady marks the given host's "RuleConditionReady" condition to unknown.
+func (is *IngressStatus) MarkHostNotReady(host, reason, message string) {
+	ruleCondSet.Manage(is.ruleStatus(host)).MarkUnknown(RuleConditionReady, reason, message)
+}
+
+// MarkHostFailed marks the given host's "RuleConditionReady" condition to false.
+func (is *IngressStatus) MarkHostFailed(host, reason, message string) {
+	ruleCondSet.Manage(is.ruleStatus(host)).MarkFalse(RuleConditionReady, reason, message)
+}
+
+// GetConditions returns the Conditions array. This enables generic handling
+// of conditions by implementing the apis.ConditionsAccessor interface.
+func (rs *RuleStatus) GetConditions() apis.Conditions {
+	return apis.Conditions(rs.Conditions)
+}
+
+// SetConditions sets the Conditions array. This enables generic handling
+// of conditions by implementing the apis.ConditionsAccessor interface.
+func (rs *RuleStatus) SetConditions(c apis.Conditions) {
+	rs.Conditions = duckv1.Conditions(c)
+}
+
+// GetCondition returns the current condition of a given condition type
+func (rs *RuleStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return ruleCondSet.Manage(rs).GetCondition(t)
+}
+
+// IsReady returns true if the host the RuleStatus refers to is ready.
+func (rs *RuleStatus) IsReady() bool {
+	return ruleCondSet.Manage(rs).IsHappy()
+}
+
+// normalizeLoadBalancerIngresses returns a copy of the load balancer ingress points
+// with IP populated from IPs and vice versa, so that single-stack and dual-stack
+// consumers see the same addresses. The caller's slice is left untouched.
+func normalizeLoadBalancerIngresses(lbs []LoadBalancerIngressStatus) []LoadBalancerIngressStatus {
+	if lbs == nil {
+		return nil
+	}
+	out := make([]LoadBalancerIngressStatus, len(lbs))
+	for i := range lbs {
+		lbs[i].DeepCopyInto(&out[i])
+		lb := &out[i]
+		switch {
+		case lb.IP == "" && len(lb.IPs) > 0:
+			lb.IP = lb.IPs[0]
+		case lb.IP != "" && !slices.Contains(lb.IPs, lb.IP):
+			lb.IPs = append([]string{lb.IP}, lb.IPs...)
+		}
+	}
+	return out
+}
@@ -17,9 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/apis/duck"
@@ -82,6 +84,17 @@ func TestIngressTypicalFlow(t *testing.T) {
 	apistest.CheckConditionFailed(r, IngressConditionLoadBalancerReady, t)
 	apistest.CheckConditionFailed(r, IngressConditionLoadBalancerReady, t)
 
+	// Then certificates are being configured.
+	r.MarkTLSNotConfigured("Pending", "Waiting for the certificate")
+	apistest.CheckConditionOngoing(r, IngressConditionTLSConfigured, t)
+
+	r.MarkTLSConfigurationFailed("CertificateExpired", "the certificate has expired")
+	apistest.CheckConditionFailed(r, IngressConditionTLSConfigured, t)
+	apistest.CheckConditionFailed(r, IngressConditionReady, t)
+
+	r.MarkTLSConfigured()
+	apistest.CheckConditionSucceeded(r, IngressConditionTLSConfigured, t)
+
 	// Then ingress has address.
 	r.MarkLoadBalancerReady(
 		[]LoadBalancerIngressStatus{{DomainInternal: "gateway.default.svc"}},
@@ -111,6 +124,133 @@ func TestIngressTypicalFlow(t *testing.T) {
 	apistest.CheckConditionOngoing(r, IngressConditionReady, t)
 }
 
+func TestIngressTLSConfigurationFailed(t *testing.T) {
+	r := &IngressStatus{}
+	r.InitializeConditions()
+	r.MarkNetworkConfigured()
+	r.MarkLoadBalancerReady(
+		[]LoadBalancerIngressStatus{{DomainInternal: "gateway.default.svc"}},
+		[]LoadBalancerIngressStatus{{DomainInternal: "private.gateway.default.svc"}},
+	)
+
+	// The certificates haven't been configured yet.
+	apistest.CheckConditionOngoing(r, IngressConditionTLSConfigured, t)
+	apistest.CheckConditionOngoing(r, IngressConditionReady, t)
+
+	r.MarkTLSConfigured()
+	apistest.CheckConditionSucceeded(r, IngressConditionReady, t)
+
+	// A certificate not matching the hosts makes the Ingress not ready.
+	r.MarkTLSConfigurationFailed("CertificateHostMismatch", "the certificate doesn't cover foo.example.com")
+	apistest.CheckConditionFailed(r, IngressConditionReady, t)
+	if i := (&Ingress{Status: *r}); i.IsReady() {
+		t.Fatal("IsReady()=true, wanted false")
+	}
+
+	r.MarkTLSConfigured()
+	r.MarkTLSConfigurationFailed("CertificateExpired", "the certificate has expired")
+	apistest.CheckConditionFailed(r, IngressConditionReady, t)
+}
+
+func TestIngressMarkLoadBalancerReadyWithInternal(t *testing.T) {
+	r := &IngressStatus{}
+	r.InitializeConditions()
+
+	public := []LoadBalancerIngressStatus{{DomainInternal: "gateway.default.svc"}}
+	private := []LoadBalancerIngressStatus{{DomainInternal: "private.gateway.default.svc"}}
+	internal := []LoadBalancerIngressStatus{{IP: "10.0.0.1"}}
+	r.MarkLoadBalancerReadyWithInternal(public, private, internal)
+	apistest.CheckConditionSucceeded(r, IngressConditionLoadBalancerReady, t)
+
+	want := &IngressStatus{
+		PublicLoadBalancer:  &LoadBalancerStatus{Ingress: public},
+		PrivateLoadBalancer: &LoadBalancerStatus{Ingress: private},
+		InternalLoadBalancer: &LoadBalancerStatus{Ingress: []LoadBalancerIngressStatus{{
+			IP:  "10.0.0.1",
+			IPs: []string{"10.0.0.1"},
+		}}},
+	}
+	if diff := cmp.Diff(want, r, cmpopts.IgnoreFields(IngressStatus{}, "Status")); diff != "" {
+		t.Error("Unexpected load balancer status (-want, +got) =", diff)
+	}
+}
+
+func TestIngressMarkLoadBalancerReadyDualStack(t *testing.T) {
+	r := &IngressStatus{}
+	r.InitializeConditions()
+
+	ports := []corev1.PortStatus{{Port: 8080, Protocol: corev1.ProtocolTCP}}
+	public := []LoadBalancerIngressStatus{{IPs: []string{"10.0.0.1", "2001:db8::1"}, Ports: ports}}
+	private := []LoadBalancerIngressStatus{{IP: "10.0.0.2", IPs: []string{"2001:db8::2"}}, {DomainInternal: "private.gateway.default.svc"}}
+	originalPublic, originalPrivate := slices.Clone(public), slices.Clone(private)
+	r.MarkLoadBalancerReady(public, private)
+
+	want := &IngressStatus{
+		PublicLoadBalancer: &LoadBalancerStatus{Ingress: []LoadBalancerIngressStatus{{
+			IP:    "10.0.0.1",
+			IPs:   []string{"10.0.0.1", "2001:db8::1"},
+			Ports: ports,
+		}}},
+		PrivateLoadBalancer: &LoadBalancerStatus{Ingress: []LoadBalancerIngressStatus{{
+			IP:  "10.0.0.2",
+			IPs: []string{"10.0.0.2", "2001:db8::2"},
+		}, {
+			DomainInternal: "private.gateway.default.svc",
+		}}},
+	}
+	if diff := cmp.Diff(want, r, cmpopts.IgnoreFields(IngressStatus{}, "Status")); diff != "" {
+		t.Error("Unexpected load balancer status (-want, +got) =", diff)
+	}
+
+	// The caller's load balancer ingress points are left untouched.
+	if diff := cmp.Diff(originalPublic, public); diff != "" {
+		t.Error("Public load balancer ingress points were modified (-want, +got) =", diff)
+	}
+	if diff := cmp.Diff(originalPrivate, private); diff != "" {
+		t.Error("Private load balancer ingress points were modified (-want, +got) =", diff)
+	}
+}
+
+func TestIngressHostReadiness(t *testing.T) {
+	r := &IngressStatus{}
+	r.InitializeConditions()
+
+	if rs := r.GetRuleStatus("foo.example.com"); rs != nil {
+		t.Fatalf("GetRuleStatus() = %v, wanted nil", rs)
+	}
+
+	r.MarkHostNotReady("foo.example.com", "Probing", "Waiting for the host to be probed")
+	r.MarkHostNotReady("bar.example.com", "Probing", "Waiting for the host to be probed")
+	foo := r.GetRuleStatus("foo.example.com")
+	apistest.CheckConditionOngoing(foo, RuleConditionReady, t)
+	if foo.IsReady() {
+		t.Error("IsReady()=true, wanted false")
+	}
+
+	url := apis.HTTP("foo.example.com")
+	r.MarkHostReady("foo.example.com", url)
+	r.MarkHostFailed("bar.example.com", "BadCertificate", "The certificate is invalid")
+
+	foo = r.GetRuleStatus("foo.example.com")
+	apistest.CheckConditionSucceeded(foo, RuleConditionReady, t)
+	if !foo.IsReady() {
+		t.Error("IsReady()=false, wanted true")
+	}
+	if !cmp.Equal(foo.URL, url) {
+		t.Errorf("URL = %v, wanted %v", foo.URL, url)
+	}
+
+	bar := r.GetRuleStatus("bar.example.com")
+	apistest.CheckConditionFailed(bar, RuleConditionReady, t)
+	if got, want := bar.GetCondition(RuleConditionReady).Reason, "BadCertificate"; got != want {
+		t.Errorf("Reason = %q, wanted %q", got, want)
+	}
+
+	if got, want := len(r.Rules), 2; got != want {
+		t.Errorf("len(Rules) = %d, wanted %d", got, want)
+	}
+}
+
 func TestIngressGetCondition(t *testing.T) {
 	ingressStatus := &IngressStatus{}
 	ingressStatus.InitializeConditions()
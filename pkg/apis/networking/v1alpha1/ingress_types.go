@@ -17,11 +17,14 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
+
+	netvalidation "knative.dev/networking/pkg/validation"
 )
 
 // +genclient
@@ -88,8 +91,8 @@ type IngressList struct {
 // - Timeout & Retry can be configured.
 // - Headers can be appended.
 type IngressSpec struct {
-	// TLS configuration. Currently Ingress only supports a single TLS
-	// port: 443. If multiple members of this list specify different hosts, they
+	// TLS configuration. The TLS port defaults to 443 and can be set per
+	// member of this list. If multiple members of this list specify different hosts, they
 	// will be multiplexed on the same port according to the hostname specified
 	// through the SNI TLS extension, if the ingress controller fulfilling the
 	// ingress supports SNI.
@@ -103,8 +106,152 @@ type IngressSpec struct {
 	// HTTPOption is the option of HTTP. It has the following two values:
 	// `HTTPOptionEnabled`, `HTTPOptionRedirected`
 	HTTPOption HTTPOption `json:"httpOption,omitempty"`
+
+	// HTTP3 is the option of HTTP/3 on the TLS listeners of the Ingress.
+	// When enabled, HTTP/3 is served over QUIC on the same port as TLS, and
+	// advertised to HTTP/1.1 and HTTP/2 clients through the `Alt-Svc` header.
+	// It has the following two values: `HTTP3OptionEnabled`, `HTTP3OptionDisabled`.
+	// If it's not specified then the `http3` key of config-network applies.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	HTTP3 HTTP3Option `json:"http3,omitempty"`
+
+	// HSTS is the HTTP Strict Transport Security policy advertised through
+	// the `Strict-Transport-Security` header of the HTTPS responses of the
+	// Ingress. It is never advertised over plain HTTP.
+	// If it's not specified then the `hsts-*` keys of config-network apply.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	HSTS *HSTSPolicy `json:"hsts,omitempty"`
+
+	// Observability configures the access logging and tracing of the traffic
+	// served by the Ingress. The settings left unspecified fall back to the
+	// annotations of the Ingress, and then to the config-network defaults.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	Observability *IngressObservability `json:"observability,omitempty"`
+
+	// ErrorPages replaces the bodies of the error responses generated by the
+	// Ingress itself, e.g. when no backend is ready or a timeout elapses.
+	// The responses of the backends are never replaced. The first error page
+	// whose status codes match the response applies.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	ErrorPages []ErrorPage `json:"errorPages,omitempty"`
+}
+
+// ErrorPage maps a range of status codes to a custom response body.
+// Exactly one of Backend and Inline must be specified.
+type ErrorPage struct {
+	// StatusCodes is the range of status codes of the responses to replace.
+	StatusCodes StatusCodeRange `json:"statusCodes"`
+
+	// Backend serves the error pages. It is sent GET requests for the path of
+	// the original request, and its response body and content type are
+	// returned with the original status code.
+	// +optional
+	Backend *IngressBackend `json:"backend,omitempty"`
+
+	// Inline is the error page to respond with.
+	// +optional
+	Inline *InlineErrorPage `json:"inline,omitempty"`
+}
+
+// StatusCodeRange is an inclusive range of HTTP status codes.
+type StatusCodeRange struct {
+	// From is the first status code of the range, between 400 and 599.
+	From int `json:"from"`
+
+	// To is the last status code of the range, between From and 599.
+	// Defaults to From.
+	// +optional
+	To int `json:"to,omitempty"`
+}
+
+// DefaultErrorPageContentType is the media type of the inline error pages
+// not specifying one.
+const DefaultErrorPageContentType = "text/plain; charset=utf-8"
+
+// InlineErrorPage describes an error page embedded in the Ingress.
+type InlineErrorPage struct {
+	// Body is the body of the error page.
+	Body string `json:"body"`
+
+	// ContentType is the media type of the body.
+	// Defaults to DefaultErrorPageContentType.
+	// +optional
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// HSTSPolicy describes the value of the Strict-Transport-Security header,
+// as defined by RFC 6797.
+type HSTSPolicy struct {
+	// MaxAge is the number of seconds clients should only access the hosts
+	// over HTTPS for. Zero tells clients to forget the policy.
+	MaxAge int64 `json:"maxAge"`
+
+	// IncludeSubDomains applies the policy to the subdomains of the hosts as well.
+	// +optional
+	IncludeSubDomains bool `json:"includeSubDomains,omitempty"`
+
+	// Preload signals consent to have the hosts included in the HSTS preload
+	// lists of browsers. It requires IncludeSubDomains and a MaxAge of at
+	// least HSTSPreloadMinMaxAge.
+	// +optional
+	Preload bool `json:"preload,omitempty"`
+}
+
+// IngressObservability describes the access logging and tracing of the traffic
+// served by an Ingress.
+type IngressObservability struct {
+	// AccessLog configures the access logs of the requests to the Ingress.
+	// +optional
+	AccessLog *IngressAccessLog `json:"accessLog,omitempty"`
+
+	// Tracing configures the traces of the requests to the Ingress.
+	// +optional
+	Tracing *IngressTracing `json:"tracing,omitempty"`
+}
+
+// IngressAccessLog describes the access logs of the requests to an Ingress.
+type IngressAccessLog struct {
+	// Enabled specifies whether the requests to the Ingress are logged.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Fields are the fields of the JSON access log entries, among
+	// `authority`, `bytesReceived`, `bytesSent`, `duration`, `method`,
+	// `path`, `protocol`, `remoteAddress`, `requestId`, `startTime`,
+	// `status`, `upstreamHost` and `userAgent`.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+
+	// SamplingFraction is the fraction of the requests to log, as a decimal
+	// number between 0 and 1, e.g. "0.25".
+	// +optional
+	SamplingFraction string `json:"samplingFraction,omitempty"`
+}
+
+// IngressTracing describes the traces of the requests to an Ingress.
+type IngressTracing struct {
+	// SamplingRate is the fraction of the requests to trace, as a decimal
+	// number between 0 and 1, e.g. "0.01".
+	// +optional
+	SamplingRate string `json:"samplingRate,omitempty"`
 }
 
+// HSTSPreloadMinMaxAge is the minimal max-age, in seconds, of the HSTS policies
+// asking to be preloaded, as required by the browsers' preload lists.
+const HSTSPreloadMinMaxAge = netvalidation.HSTSPreloadMinMaxAge
+
 type HTTPOption string
 
 const (
@@ -116,6 +263,16 @@ const (
 	HTTPOptionRedirected HTTPOption = "Redirected"
 )
 
+type HTTP3Option string
+
+const (
+	// HTTP3OptionEnabled defines that the knative ingress will serve HTTP/3
+	// on its TLS listeners and advertise it through the Alt-Svc header.
+	HTTP3OptionEnabled HTTP3Option = "Enabled"
+	// HTTP3OptionDisabled defines that the knative ingress will not serve HTTP/3.
+	HTTP3OptionDisabled HTTP3Option = "Disabled"
+)
+
 // IngressVisibility describes whether the Ingress should be exposed to
 // public gateways or not.
 type IngressVisibility string
@@ -128,6 +285,21 @@ const (
 	// IngressVisibilityClusterLocal is used to denote that the Ingress
 	// should be only be exposed locally to the cluster.
 	IngressVisibilityClusterLocal IngressVisibility = "ClusterLocal"
+	// IngressVisibilityInternalIP is used to denote that the Ingress
+	// should be exposed via an internal IP, for example a LoadBalancer
+	// Service only reachable from within a VPC or corporate network,
+	// but not from the internet.
+	IngressVisibilityInternalIP IngressVisibility = "InternalIP"
+)
+
+const (
+	// DefaultHTTPPort is the port of the listeners serving plain HTTP traffic
+	// of rules that don't specify a port.
+	DefaultHTTPPort int32 = 80
+
+	// DefaultHTTPSPort is the port of the listeners terminating TLS of
+	// IngressTLS entries that don't specify a port.
+	DefaultHTTPSPort int32 = 443
 )
 
 // IngressTLS describes the transport layer security associated with an Ingress.
@@ -149,6 +321,13 @@ type IngressTLS struct {
 	//
 	// +optional
 	SecretNamespace string `json:"secretNamespace,omitempty"`
+
+	// Port is the port of the listener terminating TLS for the hosts.
+	// If it's not specified then it defaults to 443.
+	//
+	// NOTE: This differs from K8s Ingress which only supports port 443.
+	// +optional
+	Port int32 `json:"port,omitempty"`
 }
 
 // IngressRule represents the rules mapping the paths under a specified host to
@@ -161,22 +340,49 @@ type IngressRule struct {
 	// 1. IPs are not allowed. Currently a rule value can only apply to the
 	//	  IP in the Spec of the parent .
 	// 2. The `:` delimiter is not respected because ports are not allowed.
-	//	  Currently the port of an Ingress is implicitly :80 for http and
-	//	  :443 for https.
-	// Both these may change in the future.
+	//	  The port of a rule is :80 for http and :443 for https, unless
+	//	  overridden by Port and IngressTLS.Port respectively.
+	// The first may change in the future.
 	// If the host is unspecified, the Ingress routes all traffic based on the
 	// specified IngressRuleValue.
 	// If multiple matching Hosts were provided, the first rule will take precedent.
 	// +optional
 	Hosts []string `json:"hosts,omitempty"`
 
-	// Visibility signifies whether this rule should `ClusterLocal`. If it's not
-	// specified then it defaults to `ExternalIP`.
+	// Visibility signifies whether this rule should `ClusterLocal` or
+	// `InternalIP`. If it's not specified then it defaults to `ExternalIP`.
 	Visibility IngressVisibility `json:"visibility,omitempty"`
 
+	// Port is the port of the listener serving plain HTTP traffic for the hosts,
+	// or TLS traffic for TLSPassthrough rules.
+	// If it's not specified then it defaults to 80, or 443 for TLSPassthrough rules.
+	//
+	// NOTE: This differs from K8s Ingress which only supports port 80.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
 	// HTTP represents a rule to apply against incoming requests. If the
 	// rule is satisfied, the request is routed to the specified backend.
+	// Exactly one of HTTP and TLSPassthrough must be specified.
+	// +optional
 	HTTP *HTTPIngressRuleValue `json:"http,omitempty"`
+
+	// TLSPassthrough represents a rule routing TLS connections, based on the
+	// SNI of their ClientHello, to the specified backend without terminating
+	// TLS at the gateway. Exactly one of HTTP and TLSPassthrough must be specified.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	TLSPassthrough *TLSPassthroughRuleValue `json:"tlsPassthrough,omitempty"`
+}
+
+// TLSPassthroughRuleValue routes TCP connections carrying TLS, whose SNI
+// matches one of the rule Hosts, to a backend. The connection is forwarded
+// as is, so the backend is responsible for terminating TLS.
+type TLSPassthroughRuleValue struct {
+	// Backend is the destination the matching connections are forwarded to.
+	Backend IngressBackend `json:"backend"`
 }
 
 // HTTPIngressRuleValue is a list of http selectors pointing to backends.
@@ -190,10 +396,43 @@ type HTTPIngressRuleValue struct {
 	// If they are multiple matching paths, the first match takes precedence.
 	Paths []HTTPIngressPath `json:"paths"`
 
-	// TODO: Consider adding fields for ingress-type specific global
-	// options usable by a loadbalancer, like http keep-alive.
+	// Options holds the request handling options applying to all the paths
+	// of the rule, unless overridden by the paths themselves.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	Options *HTTPIngressRuleOptions `json:"options,omitempty"`
+}
+
+// HTTPIngressRuleOptions describes the request handling options shared by the
+// paths of an HTTPIngressRuleValue.
+type HTTPIngressRuleOptions struct {
+	// MaxRequestBodyBytes is the maximum size of the request bodies, larger
+	// requests are rejected with a 413 (Request Entity Too Large) status code.
+	// +optional
+	MaxRequestBodyBytes *int64 `json:"maxRequestBodyBytes,omitempty"`
+
+	// RequestBuffering specifies whether request bodies are streamed to the
+	// backends or buffered in full before being forwarded.
+	// +optional
+	RequestBuffering RequestBufferingMode `json:"requestBuffering,omitempty"`
 }
 
+// RequestBufferingMode describes how request bodies are forwarded to the backends.
+type RequestBufferingMode string
+
+const (
+	// RequestBufferingStreamed forwards the request bodies to the backends as
+	// they are received. This is the default.
+	RequestBufferingStreamed RequestBufferingMode = "Streamed"
+
+	// RequestBufferingBuffered buffers the request bodies in full before
+	// forwarding the requests to the backends, e.g. so that requests held
+	// while scaling from zero don't keep the clients' uploads stalled.
+	RequestBufferingBuffered RequestBufferingMode = "Buffered"
+)
+
 // HTTPIngressPath associates a path regex with a backend. Incoming URLs matching
 // the path are forwarded to the backend.
 type HTTPIngressPath struct {
@@ -229,8 +468,94 @@ type HTTPIngressPath struct {
 	// NOTE: This differs from K8s Ingress which doesn't allow header appending.
 	// +optional
 	AppendHeaders map[string]string `json:"appendHeaders,omitempty"`
+
+	// MaxRequestBodyBytes is the maximum size of the request bodies, larger
+	// requests are rejected with a 413 (Request Entity Too Large) status code.
+	// It overrides the value of the rule options.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	MaxRequestBodyBytes *int64 `json:"maxRequestBodyBytes,omitempty"`
+
+	// RequestBuffering specifies whether request bodies are streamed to the
+	// backends or buffered in full before being forwarded. It overrides the
+	// value of the rule options.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	RequestBuffering RequestBufferingMode `json:"requestBuffering,omitempty"`
+
+	// Compression specifies how the responses of the path are compressed
+	// for the clients accepting it. Responses are left as is if not specified.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	Compression *CompressionPolicy `json:"compression,omitempty"`
+
+	// ResponseTimeout is the maximum duration to wait for the response headers
+	// of the backends, after which the Ingress responds with a 504 (Gateway
+	// Timeout) status code. Requests don't time out if not specified.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	ResponseTimeout *metav1.Duration `json:"responseTimeout,omitempty"`
+
+	// IdleTimeout is the maximum duration a request, or an upgraded connection
+	// such as a WebSocket, may go without any data being exchanged in either
+	// direction before the Ingress closes it. Unlike ResponseTimeout, it keeps
+	// applying after the response headers are received. Requests are never
+	// considered idle if not specified.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// MaxStreamDuration is the maximum duration of a request, or an upgraded
+	// connection such as a WebSocket, gRPC stream or server-sent events stream,
+	// after which the Ingress closes it regardless of its activity. Streams
+	// aren't bounded if not specified.
+	//
+	// This field is currently experimental and not supported by all Ingress
+	// implementations.
+	// +optional
+	MaxStreamDuration *metav1.Duration `json:"maxStreamDuration,omitempty"`
+}
+
+// CompressionPolicy describes how the responses of a path are compressed.
+type CompressionPolicy struct {
+	// Algorithms are the content codings the responses can be compressed
+	// with, in order of preference.
+	Algorithms []CompressionAlgorithm `json:"algorithms"`
+
+	// MinResponseBytes is the minimum size of the responses to compress.
+	// Smaller responses, or responses of unknown size, are left as is.
+	// +optional
+	MinResponseBytes *int64 `json:"minResponseBytes,omitempty"`
+
+	// ContentTypes restricts the compression to the responses of the given
+	// media types, without parameters, e.g. `application/json` or `text/*`.
+	// All the responses are compressed if it's empty.
+	// +optional
+	ContentTypes []string `json:"contentTypes,omitempty"`
 }
 
+// CompressionAlgorithm is a content coding, as advertised in the
+// Content-Encoding header of the compressed responses.
+type CompressionAlgorithm string
+
+const (
+	// CompressionAlgorithmGzip compresses the responses with gzip.
+	CompressionAlgorithmGzip CompressionAlgorithm = "gzip"
+
+	// CompressionAlgorithmBrotli compresses the responses with brotli.
+	CompressionAlgorithmBrotli CompressionAlgorithm = "br"
+)
+
 // IngressBackendSplit describes all endpoints for a given service and port.
 type IngressBackendSplit struct {
 	// Specifies the backend receiving the traffic split.
@@ -250,11 +575,14 @@ type IngressBackendSplit struct {
 	AppendHeaders map[string]string `json:"appendHeaders,omitempty"`
 }
 
-// IngressBackend describes all endpoints for a given service and port.
+// IngressBackend describes all endpoints for a given service and port,
+// or a destination outside of the cluster.
 type IngressBackend struct {
 	// Specifies the namespace of the referenced service.
 	//
 	// NOTE: This differs from K8s Ingress to allow routing to different namespaces.
+	// Routing to a namespace other than the Ingress namespace requires a
+	// BackendGrant in the namespace of the referenced service.
 	ServiceNamespace string `json:"serviceNamespace"`
 
 	// Specifies the name of the referenced service.
@@ -262,6 +590,29 @@ type IngressBackend struct {
 
 	// Specifies the port of the referenced service.
 	ServicePort intstr.IntOrString `json:"servicePort"`
+
+	// External specifies a destination outside of the cluster to route
+	// traffic to. It is mutually exclusive with the Service fields above.
+	//
+	// NOTE: This differs from K8s Ingress which only supports Service backends.
+	// +optional
+	External *ExternalBackend `json:"external,omitempty"`
+}
+
+// ExternalBackend describes a destination outside of the cluster.
+type ExternalBackend struct {
+	// Host is the DNS name (or IP address, if permitted) of the external destination.
+	Host string `json:"host"`
+
+	// Port is the port of the external destination.
+	// Defaults to 80 for http and 443 for https.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Scheme is the protocol used to connect to the external destination,
+	// either http or https. Defaults to http.
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
 }
 
 // HTTPRetry is DEPRECATED. Retry is not used in KIngress.
@@ -284,6 +635,30 @@ type IngressStatus struct {
 	// PrivateLoadBalancer contains the current status of the load-balancer.
 	// +optional
 	PrivateLoadBalancer *LoadBalancerStatus `json:"privateLoadBalancer,omitempty"`
+
+	// InternalLoadBalancer contains the current status of the load-balancer
+	// serving rules with `InternalIP` visibility.
+	// +optional
+	InternalLoadBalancer *LoadBalancerStatus `json:"internalLoadBalancer,omitempty"`
+
+	// Rules contains the readiness of the individual hosts of the Ingress,
+	// so that a single failing host can be told apart from the others.
+	// +optional
+	Rules []RuleStatus `json:"rules,omitempty"`
+}
+
+// RuleStatus describes the current state of a single host of the Ingress.
+type RuleStatus struct {
+	// Host is the host of the IngressRule this status refers to.
+	Host string `json:"host"`
+
+	// URL is the URL at which the host has been observed to be served.
+	// +optional
+	URL *apis.URL `json:"url,omitempty"`
+
+	// Conditions the latest available observations of the host's state.
+	// +optional
+	Conditions duckv1.Conditions `json:"conditions,omitempty"`
 }
 
 // LoadBalancerStatus represents the status of a load-balancer.
@@ -302,6 +677,12 @@ type LoadBalancerIngressStatus struct {
 	// +optional
 	IP string `json:"ip,omitempty"`
 
+	// IPs contains all the addresses of IP based load-balancer ingress points,
+	// of any IP family. On dual-stack clusters, it holds both the IPv4 and the
+	// IPv6 addresses. When set, IP is the first of them.
+	// +optional
+	IPs []string `json:"ips,omitempty"`
+
 	// Domain is set for load-balancer ingress points that are DNS based
 	// (typically AWS load-balancers)
 	// +optional
@@ -318,6 +699,11 @@ type LoadBalancerIngressStatus struct {
 	// MeshOnly is set if the Ingress is only load-balanced through a Service mesh.
 	// +optional
 	MeshOnly bool `json:"meshOnly,omitempty"`
+
+	// Ports is a list of the ports the load-balancer ingress point listens on.
+	// If it's empty, the default ports (80 for http and 443 for https) are used.
+	// +optional
+	Ports []corev1.PortStatus `json:"ports,omitempty"`
 }
 
 // ConditionType represents a Ingress condition value
@@ -334,6 +720,15 @@ const (
 
 	// IngressConditionLoadBalancerReady is set when the Ingress has a ready LoadBalancer.
 	IngressConditionLoadBalancerReady apis.ConditionType = "LoadBalancerReady"
+
+	// IngressConditionTLSConfigured is set when the certificates referenced by
+	// the Ingress TLS settings are valid and have been configured. Ingresses
+	// without TLS settings should have it marked as true as well.
+	IngressConditionTLSConfigured apis.ConditionType = "TLSConfigured"
+
+	// RuleConditionReady is set on a RuleStatus when the host it refers to
+	// has been programmed and is being served.
+	RuleConditionReady = apis.ConditionReady
 )
 
 // GetStatus retrieves the status of the Ingress. Implements the KRShaped interface.
@@ -18,11 +18,21 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"mime"
+	"net"
 	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
+
+	netvalidation "knative.dev/networking/pkg/validation"
 )
 
 // Validate inspects and validates Ingress object.
@@ -50,10 +60,97 @@ func (is *IngressSpec) Validate(ctx context.Context) *apis.FieldError {
 	for idx, tls := range is.TLS {
 		all = all.Also(tls.Validate(ctx).ViaFieldIndex("tls", idx))
 	}
+	all = all.Also(is.validateListenerPortCollisions())
 	all = all.Also(is.HTTPOption.Validate(ctx))
+	all = all.Also(is.HTTP3.Validate(ctx))
+	// HTTP/3 is only served on TLS listeners.
+	if is.HTTP3 == HTTP3OptionEnabled && len(is.TLS) == 0 {
+		all = all.Also(&apis.FieldError{
+			Message: "HTTP/3 requires TLS to be configured",
+			Paths:   []string{"http3"},
+		})
+	}
+	if is.HSTS != nil {
+		all = all.Also(is.HSTS.Validate(ctx).ViaField("hsts"))
+		// HSTS is only advertised on TLS listeners.
+		if len(is.TLS) == 0 {
+			all = all.Also(&apis.FieldError{
+				Message: "HSTS requires TLS to be configured",
+				Paths:   []string{"hsts"},
+			})
+		}
+	}
+	if is.Observability != nil {
+		all = all.Also(is.Observability.Validate(ctx).ViaField("observability"))
+	}
+	for idx, page := range is.ErrorPages {
+		all = all.Also(page.Validate(ctx).ViaFieldIndex("errorPages", idx))
+		// Each status code is replaced by a single error page.
+		for prev := range idx {
+			if page.StatusCodes.overlaps(is.ErrorPages[prev].StatusCodes) {
+				all = all.Also((&apis.FieldError{
+					Message: fmt.Sprintf("status codes overlap with errorPages[%d]", prev),
+					Paths:   []string{"statusCodes"},
+				}).ViaFieldIndex("errorPages", idx))
+			}
+		}
+	}
+	return all
+}
+
+// Validate inspects and validates ErrorPage object.
+func (e *ErrorPage) Validate(ctx context.Context) *apis.FieldError {
+	all := e.StatusCodes.Validate(ctx).ViaField("statusCodes")
+	switch {
+	case e.Backend == nil && e.Inline == nil:
+		all = all.Also(apis.ErrMissingOneOf("backend", "inline"))
+	case e.Backend != nil && e.Inline != nil:
+		all = all.Also(apis.ErrMultipleOneOf("backend", "inline"))
+	case e.Backend != nil:
+		all = all.Also(e.Backend.Validate(ctx).ViaField("backend"))
+	default:
+		all = all.Also(e.Inline.Validate(ctx).ViaField("inline"))
+	}
+	return all
+}
+
+// Validate inspects and validates StatusCodeRange object.
+func (r StatusCodeRange) Validate(context.Context) *apis.FieldError {
+	var all *apis.FieldError
+	if r.From < 400 || r.From > 599 {
+		all = all.Also(apis.ErrOutOfBoundsValue(r.From, 400, 599, "from"))
+	}
+	// To is defaulted to From.
+	if r.To != 0 && (r.To < r.From || r.To > 599) {
+		all = all.Also(apis.ErrOutOfBoundsValue(r.To, r.From, 599, "to"))
+	}
 	return all
 }
 
+// overlaps returns whether the two ranges share any status code.
+func (r StatusCodeRange) overlaps(other StatusCodeRange) bool {
+	return r.From <= other.last() && other.From <= r.last()
+}
+
+// last returns the last status code of the range.
+func (r StatusCodeRange) last() int {
+	if r.To == 0 {
+		return r.From
+	}
+	return r.To
+}
+
+// Validate inspects and validates InlineErrorPage object.
+func (p *InlineErrorPage) Validate(context.Context) *apis.FieldError {
+	if p.ContentType == "" {
+		return nil
+	}
+	if _, _, err := mime.ParseMediaType(p.ContentType); err != nil {
+		return apis.ErrInvalidValue(p.ContentType, "contentType", err.Error())
+	}
+	return nil
+}
+
 // Validate inspects and validates IngressRule object.
 func (r *IngressRule) Validate(ctx context.Context) *apis.FieldError {
 	// Provided rule must not be empty.
@@ -61,10 +158,30 @@ func (r *IngressRule) Validate(ctx context.Context) *apis.FieldError {
 		return apis.ErrMissingField(apis.CurrentField)
 	}
 	var all *apis.FieldError
-	if r.HTTP == nil {
-		all = all.Also(apis.ErrMissingField("http"))
-	} else {
+	all = all.Also(validateListenerPort(r.Port))
+	switch {
+	case r.HTTP == nil && r.TLSPassthrough == nil:
+		all = all.Also(apis.ErrMissingOneOf("http", "tlsPassthrough"))
+	case r.HTTP != nil && r.TLSPassthrough != nil:
+		all = all.Also(apis.ErrMultipleOneOf("http", "tlsPassthrough"))
+	case r.HTTP != nil:
 		all = all.Also(r.HTTP.Validate(ctx).ViaField("http"))
+	default:
+		// Routing is based on the SNI, so the hosts must be explicit.
+		if len(r.Hosts) == 0 {
+			all = all.Also(apis.ErrMissingField("hosts"))
+		}
+		all = all.Also(r.TLSPassthrough.Validate(ctx).ViaField("tlsPassthrough"))
+	}
+	return all
+}
+
+// Validate inspects and validates TLSPassthroughRuleValue object.
+func (t *TLSPassthroughRuleValue) Validate(ctx context.Context) *apis.FieldError {
+	all := t.Backend.Validate(ctx).ViaField("backend")
+	if t.Backend.External != nil && t.Backend.External.Scheme != "" {
+		// The connection is not terminated, so there is no scheme to pick.
+		all = all.Also(apis.ErrDisallowedFields("scheme").ViaField("backend", "external"))
 	}
 	return all
 }
@@ -78,6 +195,27 @@ func (h *HTTPIngressRuleValue) Validate(ctx context.Context) *apis.FieldError {
 	for idx, path := range h.Paths {
 		all = all.Also(path.Validate(ctx).ViaFieldIndex("paths", idx))
 	}
+	if h.Options != nil {
+		all = all.Also(h.Options.Validate(ctx).ViaField("options"))
+	}
+	return all
+}
+
+// Validate inspects and validates HTTPIngressRuleOptions object.
+func (o *HTTPIngressRuleOptions) Validate(context.Context) *apis.FieldError {
+	return validateRequestBodyOptions(o.MaxRequestBodyBytes, o.RequestBuffering)
+}
+
+func validateRequestBodyOptions(maxBytes *int64, buffering RequestBufferingMode) *apis.FieldError {
+	var all *apis.FieldError
+	if maxBytes != nil && *maxBytes < 1 {
+		all = all.Also(apis.ErrOutOfBoundsValue(*maxBytes, 1, math.MaxInt64, "maxRequestBodyBytes"))
+	}
+	switch buffering {
+	case "", RequestBufferingStreamed, RequestBufferingBuffered:
+	default:
+		all = all.Also(apis.ErrInvalidValue(buffering, "requestBuffering"))
+	}
 	return all
 }
 
@@ -108,7 +246,49 @@ func (h HTTPIngressPath) Validate(ctx context.Context) *apis.FieldError {
 			})
 		}
 	}
+	all = all.Also(validateRequestBodyOptions(h.MaxRequestBodyBytes, h.RequestBuffering))
+	if h.Compression != nil {
+		all = all.Also(h.Compression.Validate(ctx).ViaField("compression"))
+	}
+	all = all.Also(validateTimeout(h.ResponseTimeout, "responseTimeout"))
+	all = all.Also(validateTimeout(h.IdleTimeout, "idleTimeout"))
+	all = all.Also(validateTimeout(h.MaxStreamDuration, "maxStreamDuration"))
+
+	return all
+}
 
+// validateTimeout checks that the given optional timeout is positive.
+func validateTimeout(timeout *metav1.Duration, field string) *apis.FieldError {
+	if timeout != nil && timeout.Duration <= 0 {
+		return apis.ErrInvalidValue(timeout.Duration.String(), field, "must be positive")
+	}
+	return nil
+}
+
+// Validate inspects and validates CompressionPolicy object.
+func (c *CompressionPolicy) Validate(context.Context) *apis.FieldError {
+	var all *apis.FieldError
+	if len(c.Algorithms) == 0 {
+		all = all.Also(apis.ErrMissingField("algorithms"))
+	}
+	seen := make(sets.Set[CompressionAlgorithm], len(c.Algorithms))
+	for idx, algorithm := range c.Algorithms {
+		if (algorithm != CompressionAlgorithmGzip && algorithm != CompressionAlgorithmBrotli) || seen.Has(algorithm) {
+			all = all.Also(apis.ErrInvalidArrayValue(algorithm, "algorithms", idx))
+		}
+		seen.Insert(algorithm)
+	}
+	if c.MinResponseBytes != nil && *c.MinResponseBytes < 0 {
+		all = all.Also(apis.ErrOutOfBoundsValue(*c.MinResponseBytes, 0, math.MaxInt64, "minResponseBytes"))
+	}
+	for idx, contentType := range c.ContentTypes {
+		// Only bare, lowercase type/subtype media types are supported.
+		mediaType, params, err := mime.ParseMediaType(contentType)
+		typ, subtype, _ := strings.Cut(mediaType, "/")
+		if err != nil || len(params) > 0 || mediaType != contentType || typ == "" || subtype == "" {
+			all = all.Also(apis.ErrInvalidArrayValue(contentType, "contentTypes", idx))
+		}
+	}
 	return all
 }
 
@@ -134,13 +314,23 @@ func (b IngressBackend) Validate(ctx context.Context) *apis.FieldError {
 		return apis.ErrMissingField(apis.CurrentField)
 	}
 	var all *apis.FieldError
+	if b.External != nil {
+		// External backends are mutually exclusive with the Service fields.
+		if b.ServiceNamespace != "" {
+			all = all.Also(apis.ErrDisallowedFields("serviceNamespace"))
+		}
+		if b.ServiceName != "" {
+			all = all.Also(apis.ErrDisallowedFields("serviceName"))
+		}
+		if !equality.Semantic.DeepEqual(b.ServicePort, intstr.IntOrString{}) {
+			all = all.Also(apis.ErrDisallowedFields("servicePort"))
+		}
+		return all.Also(b.External.Validate(ctx).ViaField("external"))
+	}
+	// Backends in a different namespace than the Ingress are allowed here,
+	// whether they are permitted is decided by BackendGrants at reconcile time.
 	if b.ServiceNamespace == "" {
 		all = all.Also(apis.ErrMissingField("serviceNamespace"))
-	} else if b.ServiceNamespace != apis.ParentMeta(ctx).Namespace {
-		all = all.Also(&apis.FieldError{
-			Message: "service namespace must match ingress namespace",
-			Paths:   []string{"serviceNamespace"},
-		})
 	}
 	if b.ServiceName == "" {
 		all = all.Also(apis.ErrMissingField("serviceName"))
@@ -151,6 +341,35 @@ func (b IngressBackend) Validate(ctx context.Context) *apis.FieldError {
 	return all
 }
 
+// Validate inspects and validates ExternalBackend object.
+func (e *ExternalBackend) Validate(ctx context.Context) *apis.FieldError {
+	var all *apis.FieldError
+	switch {
+	case e.Host == "":
+		all = all.Also(apis.ErrMissingField("host"))
+	case net.ParseIP(e.Host) != nil:
+		if !AreExternalIPLiteralsAllowed(ctx) {
+			all = all.Also(&apis.FieldError{
+				Message: "IP literals are not allowed for external backends",
+				Paths:   []string{"host"},
+			})
+		}
+	default:
+		if errs := validation.IsDNS1123Subdomain(e.Host); len(errs) > 0 {
+			all = all.Also(apis.ErrInvalidValue(e.Host, "host", strings.Join(errs, ", ")))
+		}
+	}
+	if e.Port < 0 || e.Port > 65535 {
+		all = all.Also(apis.ErrOutOfBoundsValue(e.Port, 1, 65535, "port"))
+	}
+	switch e.Scheme {
+	case "", "http", "https":
+	default:
+		all = all.Also(apis.ErrInvalidValue(e.Scheme, "scheme"))
+	}
+	return all
+}
+
 // Validate inspects and validates IngressTLS object.
 func (t *IngressTLS) Validate(_ context.Context) *apis.FieldError {
 	// Provided TLS setting must not be empty.
@@ -165,9 +384,50 @@ func (t *IngressTLS) Validate(_ context.Context) *apis.FieldError {
 	if t.SecretNamespace == "" {
 		all = all.Also(apis.ErrMissingField("secretNamespace"))
 	}
+	return all.Also(validateListenerPort(t.Port))
+}
+
+// validateListenerPortCollisions checks that the plain HTTP rules don't listen
+// on the port of a TLS listener of the Ingress.
+func (is *IngressSpec) validateListenerPortCollisions() *apis.FieldError {
+	tlsPorts := make(map[int32]int, len(is.TLS))
+	for idx := len(is.TLS) - 1; idx >= 0; idx-- {
+		port := is.TLS[idx].Port
+		if port == 0 {
+			port = DefaultHTTPSPort
+		}
+		tlsPorts[port] = idx
+	}
+	var all *apis.FieldError
+	for idx, rule := range is.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		port := rule.Port
+		if port == 0 {
+			port = DefaultHTTPPort
+		}
+		if tlsIdx, ok := tlsPorts[port]; ok {
+			all = all.Also((&apis.FieldError{
+				Message: fmt.Sprintf("port %d is used by the TLS listener of tls[%d]", port, tlsIdx),
+				Paths:   []string{"port"},
+			}).ViaFieldIndex("rules", idx))
+		}
+	}
 	return all
 }
 
+// validateListenerPort validates an optional listener port.
+func validateListenerPort(port int32) *apis.FieldError {
+	switch {
+	case port == 0:
+		return nil
+	case port < 0 || port > 65535:
+		return apis.ErrOutOfBoundsValue(port, 1, 65535, "port")
+	}
+	return nil
+}
+
 func (t HTTPOption) Validate(_ context.Context) (all *apis.FieldError) {
 	switch t {
 	case "", HTTPOptionEnabled, HTTPOptionRedirected:
@@ -177,3 +437,63 @@ func (t HTTPOption) Validate(_ context.Context) (all *apis.FieldError) {
 	}
 	return all
 }
+
+// Validate inspects and validates HTTP3Option object.
+func (t HTTP3Option) Validate(_ context.Context) (all *apis.FieldError) {
+	switch t {
+	case "", HTTP3OptionEnabled, HTTP3OptionDisabled:
+		break
+	default:
+		all = all.Also(apis.ErrInvalidValue(t, "http3"))
+	}
+	return all
+}
+
+// Validate inspects and validates HSTSPolicy object.
+func (h *HSTSPolicy) Validate(_ context.Context) *apis.FieldError {
+	return netvalidation.HSTSPolicy(h.MaxAge, h.IncludeSubDomains, h.Preload)
+}
+
+// Validate inspects and validates IngressObservability object.
+func (o *IngressObservability) Validate(_ context.Context) *apis.FieldError {
+	var all *apis.FieldError
+	if o.AccessLog != nil {
+		all = all.Also(netvalidation.AccessLogFields(o.AccessLog.Fields).ViaField("accessLog"))
+		all = all.Also(validateFraction(o.AccessLog.SamplingFraction, "accessLog.samplingFraction"))
+	}
+	if o.Tracing != nil {
+		all = all.Also(validateFraction(o.Tracing.SamplingRate, "tracing.samplingRate"))
+	}
+	return all
+}
+
+// validateFraction checks that the given value, if any, is a decimal number between 0 and 1.
+func validateFraction(value, field string) *apis.FieldError {
+	if value == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return apis.ErrInvalidValue(value, field, err.Error())
+	}
+	if f < 0 || f > 1 {
+		return apis.ErrOutOfBoundsValue(value, 0, 1, field)
+	}
+	return nil
+}
+
+// disallowExternalIPLiteralsKey is used as the key for associating information
+// with a context.Context.
+type disallowExternalIPLiteralsKey struct{}
+
+// DisallowExternalIPLiterals notes on the context that external backends of
+// Ingresses must reference their destination by DNS name rather than IP address.
+func DisallowExternalIPLiterals(ctx context.Context) context.Context {
+	return context.WithValue(ctx, disallowExternalIPLiteralsKey{}, struct{}{})
+}
+
+// AreExternalIPLiteralsAllowed checks whether the context allows external
+// backends to reference their destination by IP address.
+func AreExternalIPLiteralsAllowed(ctx context.Context) bool {
+	return ctx.Value(disallowExternalIPLiteralsKey{}) == nil
+}
@@ -18,12 +18,15 @@ package v1alpha1
 
 import (
 	"context"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
 )
 
 func TestIngressSpecValidation(t *testing.T) {
@@ -104,7 +107,71 @@ func TestIngressSpecValidation(t *testing.T) {
 				Hosts: []string{"example.com"},
 			}},
 		},
-		want: apis.ErrMissingField("rules[0].http"),
+		want: apis.ErrMissingOneOf("rules[0].http", "rules[0].tlsPassthrough"),
+	}, {
+		name: "tls-passthrough",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"db.example.com"},
+				TLSPassthrough: &TLSPassthroughRuleValue{
+					Backend: IngressBackend{
+						ServiceName:      "db",
+						ServiceNamespace: "default",
+						ServicePort:      intstr.FromInt(5432),
+					},
+				},
+			}},
+		},
+	}, {
+		name: "http-and-tls-passthrough",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+				TLSPassthrough: &TLSPassthroughRuleValue{
+					Backend: IngressBackend{
+						ServiceName:      "db",
+						ServiceNamespace: "default",
+						ServicePort:      intstr.FromInt(5432),
+					},
+				},
+			}},
+		},
+		want: apis.ErrMultipleOneOf("rules[0].http", "rules[0].tlsPassthrough"),
+	}, {
+		name: "tls-passthrough-missing-hosts-and-backend",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				TLSPassthrough: &TLSPassthroughRuleValue{},
+			}},
+		},
+		want: apis.ErrMissingField("rules[0].hosts", "rules[0].tlsPassthrough.backend"),
+	}, {
+		name: "tls-passthrough-external-scheme",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"db.example.com"},
+				TLSPassthrough: &TLSPassthroughRuleValue{
+					Backend: IngressBackend{
+						External: &ExternalBackend{
+							Host:   "db.example.org",
+							Scheme: "https",
+						},
+					},
+				},
+			}},
+		},
+		want: apis.ErrDisallowedFields("rules[0].tlsPassthrough.backend.external.scheme"),
 	}, {
 		name: "missing-http-paths",
 		is: &IngressSpec{
@@ -358,6 +425,557 @@ func TestIngressSpecValidation(t *testing.T) {
 			HTTPOption: "xyz",
 		},
 		want: apis.ErrInvalidValue("xyz", "httpOption"),
+	}, {
+		name: "http3-enabled",
+		is: &IngressSpec{
+			TLS: []IngressTLS{{
+				SecretNamespace: "secret-space",
+				SecretName:      "secret",
+			}},
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			HTTP3: HTTP3OptionEnabled,
+		},
+	}, {
+		name: "invalid-http3",
+		is: &IngressSpec{
+			TLS: []IngressTLS{{
+				SecretNamespace: "secret-space",
+				SecretName:      "secret",
+			}},
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			HTTP3: "h3",
+		},
+		want: apis.ErrInvalidValue("h3", "http3"),
+	}, {
+		name: "http3-without-tls",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			HTTP3: HTTP3OptionEnabled,
+		},
+		want: &apis.FieldError{
+			Message: "HTTP/3 requires TLS to be configured",
+			Paths:   []string{"http3"},
+		},
+	}, {
+		name: "hsts",
+		is: &IngressSpec{
+			TLS: []IngressTLS{{
+				SecretNamespace: "secret-space",
+				SecretName:      "secret",
+			}},
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			HTTPOption: HTTPOptionRedirected,
+			HSTS: &HSTSPolicy{
+				MaxAge:            HSTSPreloadMinMaxAge,
+				IncludeSubDomains: true,
+				Preload:           true,
+			},
+		},
+	}, {
+		name: "invalid-hsts",
+		is: &IngressSpec{
+			TLS: []IngressTLS{{
+				SecretNamespace: "secret-space",
+				SecretName:      "secret",
+			}},
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			HSTS: &HSTSPolicy{
+				MaxAge:  -1,
+				Preload: true,
+			},
+		},
+		want: apis.ErrInvalidValue(-1, "hsts.maxAge").Also(&apis.FieldError{
+			Message: "preload requires includeSubDomains",
+			Paths:   []string{"hsts.preload"},
+		}).Also(&apis.FieldError{
+			Message: "preload requires a maxAge of at least 31536000 seconds",
+			Paths:   []string{"hsts.preload"},
+		}),
+	}, {
+		name: "hsts-without-tls",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			HSTS: &HSTSPolicy{MaxAge: 300},
+		},
+		want: &apis.FieldError{
+			Message: "HSTS requires TLS to be configured",
+			Paths:   []string{"hsts"},
+		},
+	}, {
+		name: "observability",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			Observability: &IngressObservability{
+				AccessLog: &IngressAccessLog{
+					Enabled:          ptr.Bool(true),
+					Fields:           []string{"method", "path", "status"},
+					SamplingFraction: "0.25",
+				},
+				Tracing: &IngressTracing{
+					SamplingRate: "1",
+				},
+			},
+		},
+	}, {
+		name: "invalid-observability",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			Observability: &IngressObservability{
+				AccessLog: &IngressAccessLog{
+					Fields:           []string{"method", "cookie", "method"},
+					SamplingFraction: "1.5",
+				},
+				Tracing: &IngressTracing{
+					SamplingRate: "often",
+				},
+			},
+		},
+		want: apis.ErrInvalidArrayValue("cookie", "observability.accessLog.fields", 1).Also(
+			apis.ErrInvalidArrayValue("method", "observability.accessLog.fields", 2),
+			apis.ErrOutOfBoundsValue("1.5", 0, 1, "observability.accessLog.samplingFraction"),
+			apis.ErrInvalidValue("often", "observability.tracing.samplingRate",
+				`strconv.ParseFloat: parsing "often": invalid syntax`),
+		),
+	}, {
+		name: "request-body-options",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+						MaxRequestBodyBytes: ptr.Int64(1 << 20),
+						RequestBuffering:    RequestBufferingStreamed,
+					}},
+					Options: &HTTPIngressRuleOptions{
+						MaxRequestBodyBytes: ptr.Int64(1024),
+						RequestBuffering:    RequestBufferingBuffered,
+					},
+				},
+			}},
+		},
+	}, {
+		name: "invalid-request-body-options",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+						MaxRequestBodyBytes: ptr.Int64(0),
+						RequestBuffering:    "Sometimes",
+					}},
+					Options: &HTTPIngressRuleOptions{
+						MaxRequestBodyBytes: ptr.Int64(-1),
+						RequestBuffering:    "buffered",
+					},
+				},
+			}},
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, math.MaxInt64, "rules[0].http.paths[0].maxRequestBodyBytes").Also(
+			apis.ErrInvalidValue("Sometimes", "rules[0].http.paths[0].requestBuffering"),
+			apis.ErrOutOfBoundsValue(-1, 1, math.MaxInt64, "rules[0].http.options.maxRequestBodyBytes"),
+			apis.ErrInvalidValue("buffered", "rules[0].http.options.requestBuffering"),
+		),
+	}, {
+		name: "compression",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+						Compression: &CompressionPolicy{
+							Algorithms:       []CompressionAlgorithm{CompressionAlgorithmBrotli, CompressionAlgorithmGzip},
+							MinResponseBytes: ptr.Int64(1024),
+							ContentTypes:     []string{"application/json", "text/*"},
+						},
+					}},
+				},
+			}},
+		},
+	}, {
+		name: "invalid-compression",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+						Compression: &CompressionPolicy{
+							Algorithms:       []CompressionAlgorithm{CompressionAlgorithmGzip, "deflate", CompressionAlgorithmGzip},
+							MinResponseBytes: ptr.Int64(-1),
+							ContentTypes:     []string{"application/json; charset=utf-8", "json"},
+						},
+					}},
+				},
+			}},
+		},
+		want: apis.ErrInvalidArrayValue("deflate", "rules[0].http.paths[0].compression.algorithms", 1).Also(
+			apis.ErrInvalidArrayValue("gzip", "rules[0].http.paths[0].compression.algorithms", 2),
+			apis.ErrOutOfBoundsValue(-1, 0, math.MaxInt64, "rules[0].http.paths[0].compression.minResponseBytes"),
+			apis.ErrInvalidArrayValue("application/json; charset=utf-8", "rules[0].http.paths[0].compression.contentTypes", 0),
+			apis.ErrInvalidArrayValue("json", "rules[0].http.paths[0].compression.contentTypes", 1),
+		),
+	}, {
+		name: "missing-compression-algorithms",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+						Compression: &CompressionPolicy{},
+					}},
+				},
+			}},
+		},
+		want: apis.ErrMissingField("rules[0].http.paths[0].compression.algorithms"),
+	}, {
+		name: "error-pages",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			ErrorPages: []ErrorPage{{
+				StatusCodes: StatusCodeRange{From: 503},
+				Inline: &InlineErrorPage{
+					Body:        "<h1>Try again later</h1>",
+					ContentType: "text/html; charset=utf-8",
+				},
+			}, {
+				StatusCodes: StatusCodeRange{From: 500, To: 502},
+				Backend: &IngressBackend{
+					ServiceName:      "errors",
+					ServiceNamespace: "default",
+					ServicePort:      intstr.FromInt(8080),
+				},
+			}},
+		},
+	}, {
+		name: "invalid-error-pages",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+			ErrorPages: []ErrorPage{{
+				StatusCodes: StatusCodeRange{From: 200, To: 100},
+				Inline:      &InlineErrorPage{ContentType: "text/"},
+			}, {
+				StatusCodes: StatusCodeRange{From: 500, To: 599},
+			}, {
+				StatusCodes: StatusCodeRange{From: 503},
+				Inline:      &InlineErrorPage{Body: "Try again later."},
+				Backend: &IngressBackend{
+					ServiceName:      "errors",
+					ServiceNamespace: "default",
+					ServicePort:      intstr.FromInt(8080),
+				},
+			}},
+		},
+		want: apis.ErrOutOfBoundsValue(200, 400, 599, "errorPages[0].statusCodes.from").Also(
+			apis.ErrOutOfBoundsValue(100, 200, 599, "errorPages[0].statusCodes.to"),
+			apis.ErrInvalidValue("text/", "errorPages[0].inline.contentType", "mime: expected token after slash"),
+			apis.ErrMissingOneOf("errorPages[1].backend", "errorPages[1].inline"),
+			apis.ErrMultipleOneOf("errorPages[2].backend", "errorPages[2].inline"),
+			&apis.FieldError{
+				Message: "status codes overlap with errorPages[1]",
+				Paths:   []string{"errorPages[2].statusCodes"},
+			},
+		),
+	}, {
+		name: "timeouts",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+						ResponseTimeout:   &metav1.Duration{Duration: 30 * time.Second},
+						IdleTimeout:       &metav1.Duration{Duration: 5 * time.Minute},
+						MaxStreamDuration: &metav1.Duration{Duration: time.Hour},
+					}},
+				},
+			}},
+		},
+	}, {
+		name: "invalid-timeouts",
+		is: &IngressSpec{
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+						ResponseTimeout:   &metav1.Duration{},
+						IdleTimeout:       &metav1.Duration{Duration: -time.Second},
+						MaxStreamDuration: &metav1.Duration{},
+					}},
+				},
+			}},
+		},
+		want: apis.ErrInvalidValue("0s", "rules[0].http.paths[0].responseTimeout", "must be positive").Also(
+			apis.ErrInvalidValue("-1s", "rules[0].http.paths[0].idleTimeout", "must be positive"),
+			apis.ErrInvalidValue("0s", "rules[0].http.paths[0].maxStreamDuration", "must be positive"),
+		),
+	}, {
+		name: "custom-ports",
+		is: &IngressSpec{
+			TLS: []IngressTLS{{
+				SecretNamespace: "secret-space",
+				SecretName:      "secret",
+				Port:            8443,
+			}},
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				Port:  8080,
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+		},
+	}, {
+		name: "invalid-ports",
+		is: &IngressSpec{
+			TLS: []IngressTLS{{
+				SecretNamespace: "secret-space",
+				SecretName:      "secret",
+				Port:            70000,
+			}},
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				Port:  -1,
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}},
+		},
+		want: apis.ErrOutOfBoundsValue(-1, 1, 65535, "port").ViaFieldIndex("rules", 0).Also(
+			apis.ErrOutOfBoundsValue(70000, 1, 65535, "port").ViaFieldIndex("tls", 0)),
+	}, {
+		name: "http-port-collides-with-tls",
+		is: &IngressSpec{
+			TLS: []IngressTLS{{
+				SecretNamespace: "secret-space",
+				SecretName:      "secret",
+			}, {
+				SecretNamespace: "secret-space",
+				SecretName:      "other-secret",
+				Port:            8443,
+			}},
+			Rules: []IngressRule{{
+				Hosts: []string{"example.com"},
+				Port:  8443,
+				HTTP: &HTTPIngressRuleValue{
+					Paths: []HTTPIngressPath{{
+						Splits: []IngressBackendSplit{{
+							IngressBackend: IngressBackend{
+								ServiceName:      "revision-000",
+								ServiceNamespace: "default",
+								ServicePort:      intstr.FromInt(8080),
+							},
+						}},
+					}},
+				},
+			}, {
+				Hosts: []string{"passthrough.example.com"},
+				Port:  8443,
+				TLSPassthrough: &TLSPassthroughRuleValue{
+					Backend: IngressBackend{
+						ServiceName:      "revision-000",
+						ServiceNamespace: "default",
+						ServicePort:      intstr.FromInt(8443),
+					},
+				},
+			}},
+		},
+		want: (&apis.FieldError{
+			Message: "port 8443 is used by the TLS listener of tls[1]",
+			Paths:   []string{"port"},
+		}).ViaFieldIndex("rules", 0),
 	}}
 
 	ctx := apis.WithinParent(context.Background(), metav1.ObjectMeta{Namespace: "default", Name: "test-ingress"})
@@ -433,15 +1051,104 @@ func TestIngressValidation(t *testing.T) {
 				}},
 			},
 		},
+		want: nil,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.ci.Validate(context.Background())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestExternalBackendValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		b    IngressBackend
+		ctx  context.Context
+		want *apis.FieldError
+	}{{
+		name: "valid",
+		b: IngressBackend{
+			External: &ExternalBackend{
+				Host:   "api.example.com",
+				Port:   443,
+				Scheme: "https",
+			},
+		},
+	}, {
+		name: "valid without port and scheme",
+		b: IngressBackend{
+			External: &ExternalBackend{Host: "api.example.com"},
+		},
+	}, {
+		name: "ip literal",
+		b: IngressBackend{
+			External: &ExternalBackend{Host: "10.0.0.1"},
+		},
+	}, {
+		name: "ip literal disallowed",
+		b: IngressBackend{
+			External: &ExternalBackend{Host: "2001:db8::1"},
+		},
+		ctx: DisallowExternalIPLiterals(context.Background()),
 		want: &apis.FieldError{
-			Message: "service namespace must match ingress namespace",
-			Paths:   []string{"spec.rules[0].http.paths[0].splits[0].serviceNamespace"},
+			Message: "IP literals are not allowed for external backends",
+			Paths:   []string{"external.host"},
+		},
+	}, {
+		name: "dns name with ip literals disallowed",
+		b: IngressBackend{
+			External: &ExternalBackend{Host: "api.example.com"},
+		},
+		ctx: DisallowExternalIPLiterals(context.Background()),
+	}, {
+		name: "missing host",
+		b: IngressBackend{
+			External: &ExternalBackend{Port: 80},
+		},
+		want: apis.ErrMissingField("external.host"),
+	}, {
+		name: "invalid host",
+		b: IngressBackend{
+			External: &ExternalBackend{Host: "api_example.com"},
 		},
+		want: apis.ErrInvalidValue("api_example.com", "external.host",
+			"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', "+
+				"and must start and end with an alphanumeric character "+
+				"(e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+	}, {
+		name: "invalid port and scheme",
+		b: IngressBackend{
+			External: &ExternalBackend{
+				Host:   "api.example.com",
+				Port:   70000,
+				Scheme: "ftp",
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(70000, 1, 65535, "external.port").Also(
+			apis.ErrInvalidValue("ftp", "external.scheme")),
+	}, {
+		name: "service fields set",
+		b: IngressBackend{
+			ServiceName:      "revision-000",
+			ServiceNamespace: "default",
+			ServicePort:      intstr.FromInt(8080),
+			External:         &ExternalBackend{Host: "api.example.com"},
+		},
+		want: apis.ErrDisallowedFields("serviceNamespace", "serviceName", "servicePort"),
 	}}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := test.ci.Validate(context.Background())
+			ctx := test.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			got := test.b.Validate(ctx)
 			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
 				t.Error("Validate (-want, +got) =", diff)
 			}
@@ -54,6 +54,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CertificateList{},
 		&ClusterDomainClaim{},
 		&ClusterDomainClaimList{},
+		&BackendGrant{},
+		&BackendGrantList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
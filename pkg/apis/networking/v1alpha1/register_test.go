@@ -35,6 +35,9 @@ func TestRegisterHelpers(t *testing.T) {
 	}, {
 		kind: "Certificate",
 		want: "Certificate.networking.internal.knative.dev",
+	}, {
+		kind: "BackendGrant",
+		want: "BackendGrant.networking.internal.knative.dev",
 	}}
 	for _, test := range tests {
 		if got, want := Kind(test.kind), test.want; got.String() != want {
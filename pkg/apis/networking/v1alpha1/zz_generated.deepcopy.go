@@ -22,11 +22,131 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	apis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrant) DeepCopyInto(out *BackendGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrant.
+func (in *BackendGrant) DeepCopy() *BackendGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrantFrom) DeepCopyInto(out *BackendGrantFrom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrantFrom.
+func (in *BackendGrantFrom) DeepCopy() *BackendGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrantList) DeepCopyInto(out *BackendGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackendGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrantList.
+func (in *BackendGrantList) DeepCopy() *BackendGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackendGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrantSpec) DeepCopyInto(out *BackendGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]BackendGrantFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]BackendGrantTo, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrantSpec.
+func (in *BackendGrantSpec) DeepCopy() *BackendGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendGrantTo) DeepCopyInto(out *BackendGrantTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendGrantTo.
+func (in *BackendGrantTo) DeepCopy() *BackendGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
@@ -213,6 +333,96 @@ func (in *ClusterDomainClaimSpec) DeepCopy() *ClusterDomainClaimSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionPolicy) DeepCopyInto(out *CompressionPolicy) {
+	*out = *in
+	if in.Algorithms != nil {
+		in, out := &in.Algorithms, &out.Algorithms
+		*out = make([]CompressionAlgorithm, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinResponseBytes != nil {
+		in, out := &in.MinResponseBytes, &out.MinResponseBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionPolicy.
+func (in *CompressionPolicy) DeepCopy() *CompressionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPage) DeepCopyInto(out *ErrorPage) {
+	*out = *in
+	out.StatusCodes = in.StatusCodes
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(IngressBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(InlineErrorPage)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPage.
+func (in *ErrorPage) DeepCopy() *ErrorPage {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalBackend) DeepCopyInto(out *ExternalBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalBackend.
+func (in *ExternalBackend) DeepCopy() *ExternalBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSTSPolicy) DeepCopyInto(out *HSTSPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSTSPolicy.
+func (in *HSTSPolicy) DeepCopy() *HSTSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(HSTSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTP01Challenge) DeepCopyInto(out *HTTP01Challenge) {
 	*out = *in
@@ -259,6 +469,31 @@ func (in *HTTPIngressPath) DeepCopyInto(out *HTTPIngressPath) {
 			(*out)[key] = val
 		}
 	}
+	if in.MaxRequestBodyBytes != nil {
+		in, out := &in.MaxRequestBodyBytes, &out.MaxRequestBodyBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(CompressionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseTimeout != nil {
+		in, out := &in.ResponseTimeout, &out.ResponseTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxStreamDuration != nil {
+		in, out := &in.MaxStreamDuration, &out.MaxStreamDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -272,6 +507,27 @@ func (in *HTTPIngressPath) DeepCopy() *HTTPIngressPath {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPIngressRuleOptions) DeepCopyInto(out *HTTPIngressRuleOptions) {
+	*out = *in
+	if in.MaxRequestBodyBytes != nil {
+		in, out := &in.MaxRequestBodyBytes, &out.MaxRequestBodyBytes
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPIngressRuleOptions.
+func (in *HTTPIngressRuleOptions) DeepCopy() *HTTPIngressRuleOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPIngressRuleOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPIngressRuleValue) DeepCopyInto(out *HTTPIngressRuleValue) {
 	*out = *in
@@ -282,6 +538,11 @@ func (in *HTTPIngressRuleValue) DeepCopyInto(out *HTTPIngressRuleValue) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(HTTPIngressRuleOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -360,10 +621,41 @@ func (in *Ingress) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressAccessLog) DeepCopyInto(out *IngressAccessLog) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressAccessLog.
+func (in *IngressAccessLog) DeepCopy() *IngressAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressBackend) DeepCopyInto(out *IngressBackend) {
 	*out = *in
 	out.ServicePort = in.ServicePort
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalBackend)
+		**out = **in
+	}
 	return
 }
 
@@ -380,7 +672,7 @@ func (in *IngressBackend) DeepCopy() *IngressBackend {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressBackendSplit) DeepCopyInto(out *IngressBackendSplit) {
 	*out = *in
-	out.IngressBackend = in.IngressBackend
+	in.IngressBackend.DeepCopyInto(&out.IngressBackend)
 	if in.AppendHeaders != nil {
 		in, out := &in.AppendHeaders, &out.AppendHeaders
 		*out = make(map[string]string, len(*in))
@@ -434,6 +726,32 @@ func (in *IngressList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressObservability) DeepCopyInto(out *IngressObservability) {
+	*out = *in
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(IngressAccessLog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(IngressTracing)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressObservability.
+func (in *IngressObservability) DeepCopy() *IngressObservability {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressObservability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 	*out = *in
@@ -447,6 +765,11 @@ func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 		*out = new(HTTPIngressRuleValue)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLSPassthrough != nil {
+		in, out := &in.TLSPassthrough, &out.TLSPassthrough
+		*out = new(TLSPassthroughRuleValue)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -477,6 +800,23 @@ func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HSTS != nil {
+		in, out := &in.HSTS, &out.HSTS
+		*out = new(HSTSPolicy)
+		**out = **in
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(IngressObservability)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ErrorPages != nil {
+		in, out := &in.ErrorPages, &out.ErrorPages
+		*out = make([]ErrorPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -504,6 +844,18 @@ func (in *IngressStatus) DeepCopyInto(out *IngressStatus) {
 		*out = new(LoadBalancerStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InternalLoadBalancer != nil {
+		in, out := &in.InternalLoadBalancer, &out.InternalLoadBalancer
+		*out = new(LoadBalancerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RuleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -538,9 +890,53 @@ func (in *IngressTLS) DeepCopy() *IngressTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressTracing) DeepCopyInto(out *IngressTracing) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressTracing.
+func (in *IngressTracing) DeepCopy() *IngressTracing {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressTracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InlineErrorPage) DeepCopyInto(out *InlineErrorPage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InlineErrorPage.
+func (in *InlineErrorPage) DeepCopy() *InlineErrorPage {
+	if in == nil {
+		return nil
+	}
+	out := new(InlineErrorPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerIngressStatus) DeepCopyInto(out *LoadBalancerIngressStatus) {
 	*out = *in
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]corev1.PortStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -560,7 +956,9 @@ func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
 		*out = make([]LoadBalancerIngressStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -575,6 +973,34 @@ func (in *LoadBalancerStatus) DeepCopy() *LoadBalancerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleStatus) DeepCopyInto(out *RuleStatus) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(apis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(duckv1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleStatus.
+func (in *RuleStatus) DeepCopy() *RuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerlessService) DeepCopyInto(out *ServerlessService) {
 	*out = *in
@@ -669,3 +1095,36 @@ func (in *ServerlessServiceStatus) DeepCopy() *ServerlessServiceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusCodeRange) DeepCopyInto(out *StatusCodeRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusCodeRange.
+func (in *StatusCodeRange) DeepCopy() *StatusCodeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCodeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPassthroughRuleValue) DeepCopyInto(out *TLSPassthroughRuleValue) {
+	*out = *in
+	in.Backend.DeepCopyInto(&out.Backend)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPassthroughRuleValue.
+func (in *TLSPassthroughRuleValue) DeepCopy() *TLSPassthroughRuleValue {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPassthroughRuleValue)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Reason is a machine-readable reason why a TLS secret is invalid.
+// The values are suitable to be surfaced as condition reasons.
+type Reason string
+
+const (
+	// ReasonSecretNotFound is used when the referenced secret does not exist.
+	ReasonSecretNotFound Reason = "SecretNotFound"
+
+	// ReasonMissingCertificate is used when the secret has no CertName entry.
+	ReasonMissingCertificate Reason = "MissingCertificate"
+
+	// ReasonMissingPrivateKey is used when the secret has no PrivateKeyName entry.
+	ReasonMissingPrivateKey Reason = "MissingPrivateKey"
+
+	// ReasonMalformedCertificate is used when the certificate cannot be parsed.
+	ReasonMalformedCertificate Reason = "MalformedCertificate"
+
+	// ReasonMalformedPrivateKey is used when the private key cannot be parsed.
+	ReasonMalformedPrivateKey Reason = "MalformedPrivateKey"
+
+	// ReasonKeyMismatch is used when the private key does not match the certificate.
+	ReasonKeyMismatch Reason = "KeyMismatch"
+
+	// ReasonNotYetValid is used when the certificate is not valid yet.
+	ReasonNotYetValid Reason = "CertificateNotYetValid"
+
+	// ReasonExpired is used when the certificate has expired.
+	ReasonExpired Reason = "CertificateExpired"
+
+	// ReasonHostNotCovered is used when a host is not covered by the certificate SANs.
+	ReasonHostNotCovered Reason = "HostNotCovered"
+)
+
+// ValidationError describes why a TLS secret is invalid.
+type ValidationError struct {
+	Reason  Reason
+	Message string
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason, e.Message)
+}
+
+// ReasonFor returns the Reason of the given error if it is a ValidationError,
+// and an empty string otherwise.
+func ReasonFor(err error) Reason {
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		return verr.Reason
+	}
+	return ""
+}
+
+func newValidationError(reason Reason, format string, args ...interface{}) *ValidationError {
+	return &ValidationError{
+		Reason:  reason,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// ValidateTLSSecret checks that the given secret contains a parseable certificate
+// chain under CertName and a matching private key under PrivateKeyName, that the
+// leaf certificate is valid at the given time and that its SANs cover all the
+// given hosts. The returned error, if any, is a *ValidationError.
+func ValidateTLSSecret(secret *corev1.Secret, hosts []string, now time.Time) error {
+	if secret == nil {
+		return newValidationError(ReasonSecretNotFound, "the secret does not exist")
+	}
+	certPEM, ok := secret.Data[CertName]
+	if !ok || len(certPEM) == 0 {
+		return newValidationError(ReasonMissingCertificate, "secret %s/%s has no %q entry",
+			secret.Namespace, secret.Name, CertName)
+	}
+	keyPEM, ok := secret.Data[PrivateKeyName]
+	if !ok || len(keyPEM) == 0 {
+		return newValidationError(ReasonMissingPrivateKey, "secret %s/%s has no %q entry",
+			secret.Namespace, secret.Name, PrivateKeyName)
+	}
+
+	leaf, err := parseLeafCertificate(certPEM)
+	if err != nil {
+		return newValidationError(ReasonMalformedCertificate, "failed to parse %q: %v", CertName, err)
+	}
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return newValidationError(ReasonMalformedPrivateKey, "failed to parse %q: %v", PrivateKeyName, err)
+	}
+	pub, ok := leaf.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(key.Public()) {
+		return newValidationError(ReasonKeyMismatch, "the private key does not match the certificate")
+	}
+
+	if now.Before(leaf.NotBefore) {
+		return newValidationError(ReasonNotYetValid, "the certificate is not valid before %s",
+			leaf.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(leaf.NotAfter) {
+		return newValidationError(ReasonExpired, "the certificate expired at %s",
+			leaf.NotAfter.Format(time.RFC3339))
+	}
+
+	for _, host := range hosts {
+		if !coversHost(leaf, host) {
+			return newValidationError(ReasonHostNotCovered, "the certificate does not cover host %q", host)
+		}
+	}
+	return nil
+}
+
+// coversHost returns whether the certificate is valid for the given host. A wildcard
+// host, such as *.example.com, is only covered by the same wildcard DNS name, as
+// VerifyHostname doesn't accept wildcard hosts.
+func coversHost(leaf *x509.Certificate, host string) bool {
+	if !strings.HasPrefix(host, "*.") {
+		return leaf.VerifyHostname(host) == nil
+	}
+	return slices.ContainsFunc(leaf.DNSNames, func(name string) bool {
+		return strings.EqualFold(name, host)
+	})
+}
+
+// parseLeafCertificate parses the first certificate of a PEM encoded chain.
+func parseLeafCertificate(data []byte) (*x509.Certificate, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// parsePrivateKey parses the first PEM encoded PKCS#1, PKCS#8 or EC private key,
+// skipping the other blocks such as EC PARAMETERS.
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no private key found")
+		}
+		if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+			return parsePrivateKeyBlock(block)
+		}
+	}
+}
+
+// parsePrivateKeyBlock parses a PKCS#1, PKCS#8 or EC private key PEM block.
+func parsePrivateKeyBlock(block *pem.Block) (crypto.Signer, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("unsupported private key format")
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var now = time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+func TestValidateTLSSecret(t *testing.T) {
+	certPEM, keyPEM := mustCreateCert(t, now.Add(-time.Hour), now.Add(time.Hour), "example.com", "*.apps.example.com")
+	_, otherKeyPEM := mustCreateCert(t, now.Add(-time.Hour), now.Add(time.Hour), "example.com")
+	// The output of `openssl ecparam -genkey` starts with the curve parameters (prime256v1).
+	ecParamsPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "EC PARAMETERS",
+		Bytes: []byte{0x06, 0x08, 0x2a, 0x86, 0x48, 0xce, 0x3d, 0x03, 0x01, 0x07},
+	})
+
+	tests := []struct {
+		name   string
+		secret *corev1.Secret
+		hosts  []string
+		now    time.Time
+		want   Reason
+	}{{
+		name:   "valid",
+		secret: secret(certPEM, keyPEM),
+		hosts:  []string{"example.com", "foo.apps.example.com"},
+		now:    now,
+	}, {
+		name:   "wildcard host",
+		secret: secret(certPEM, keyPEM),
+		hosts:  []string{"*.apps.example.com"},
+		now:    now,
+	}, {
+		name:   "wildcard host not covered",
+		secret: secret(certPEM, keyPEM),
+		hosts:  []string{"*.example.com"},
+		now:    now,
+		want:   ReasonHostNotCovered,
+	}, {
+		name:   "EC parameters before the private key",
+		secret: secret(certPEM, append(ecParamsPEM, keyPEM...)),
+		hosts:  []string{"example.com"},
+		now:    now,
+	}, {
+		name: "missing secret",
+		now:  now,
+		want: ReasonSecretNotFound,
+	}, {
+		name:   "missing certificate",
+		secret: secret(nil, keyPEM),
+		now:    now,
+		want:   ReasonMissingCertificate,
+	}, {
+		name:   "missing private key",
+		secret: secret(certPEM, nil),
+		now:    now,
+		want:   ReasonMissingPrivateKey,
+	}, {
+		name:   "malformed certificate",
+		secret: secret([]byte("not a certificate"), keyPEM),
+		now:    now,
+		want:   ReasonMalformedCertificate,
+	}, {
+		name:   "malformed private key",
+		secret: secret(certPEM, []byte("not a key")),
+		now:    now,
+		want:   ReasonMalformedPrivateKey,
+	}, {
+		name:   "key mismatch",
+		secret: secret(certPEM, otherKeyPEM),
+		now:    now,
+		want:   ReasonKeyMismatch,
+	}, {
+		name:   "not yet valid",
+		secret: secret(certPEM, keyPEM),
+		now:    now.Add(-2 * time.Hour),
+		want:   ReasonNotYetValid,
+	}, {
+		name:   "expired",
+		secret: secret(certPEM, keyPEM),
+		now:    now.Add(2 * time.Hour),
+		want:   ReasonExpired,
+	}, {
+		name:   "host not covered",
+		secret: secret(certPEM, keyPEM),
+		hosts:  []string{"example.com", "foo.bar.apps.example.com"},
+		now:    now,
+		want:   ReasonHostNotCovered,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateTLSSecret(test.secret, test.hosts, test.now)
+			if got := ReasonFor(err); got != test.want {
+				t.Errorf("ValidateTLSSecret() = %v, wanted reason %q", err, test.want)
+			}
+		})
+	}
+}
+
+func secret(cert, key []byte) *corev1.Secret {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "tls",
+		},
+		Data: map[string][]byte{},
+	}
+	if cert != nil {
+		s.Data[CertName] = cert
+	}
+	if key != nil {
+		s.Data[PrivateKeyName] = key
+	}
+	return s
+}
+
+func mustCreateCert(t *testing.T, notBefore, notAfter time.Time, dnsNames ...string) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal("Failed to generate key:", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: []string{Organization}},
+		DNSNames:     dnsNames,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal("Failed to create certificate:", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal("Failed to marshal key:", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "knative.dev/networking/pkg/apis/networking/v1alpha1"
+	networkingv1alpha1 "knative.dev/networking/pkg/client/clientset/versioned/typed/networking/v1alpha1"
+)
+
+// fakeBackendGrants implements BackendGrantInterface
+type fakeBackendGrants struct {
+	*gentype.FakeClientWithList[*v1alpha1.BackendGrant, *v1alpha1.BackendGrantList]
+	Fake *FakeNetworkingV1alpha1
+}
+
+func newFakeBackendGrants(fake *FakeNetworkingV1alpha1, namespace string) networkingv1alpha1.BackendGrantInterface {
+	return &fakeBackendGrants{
+		gentype.NewFakeClientWithList[*v1alpha1.BackendGrant, *v1alpha1.BackendGrantList](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("backendgrants"),
+			v1alpha1.SchemeGroupVersion.WithKind("BackendGrant"),
+			func() *v1alpha1.BackendGrant { return &v1alpha1.BackendGrant{} },
+			func() *v1alpha1.BackendGrantList { return &v1alpha1.BackendGrantList{} },
+			func(dst, src *v1alpha1.BackendGrantList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.BackendGrantList) []*v1alpha1.BackendGrant {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.BackendGrantList, items []*v1alpha1.BackendGrant) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -28,6 +28,10 @@ type FakeNetworkingV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeNetworkingV1alpha1) BackendGrants(namespace string) v1alpha1.BackendGrantInterface {
+	return newFakeBackendGrants(c, namespace)
+}
+
 func (c *FakeNetworkingV1alpha1) Certificates(namespace string) v1alpha1.CertificateInterface {
 	return newFakeCertificates(c, namespace)
 }
@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type BackendGrantExpansion interface{}
+
 type CertificateExpansion interface{}
 
 type ClusterDomainClaimExpansion interface{}
@@ -28,6 +28,7 @@ import (
 
 type NetworkingV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	BackendGrantsGetter
 	CertificatesGetter
 	ClusterDomainClaimsGetter
 	IngressesGetter
@@ -39,6 +40,10 @@ type NetworkingV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *NetworkingV1alpha1Client) BackendGrants(namespace string) BackendGrantInterface {
+	return newBackendGrants(c, namespace)
+}
+
 func (c *NetworkingV1alpha1Client) Certificates(namespace string) CertificateInterface {
 	return newCertificates(c, namespace)
 }
@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// BackendGrants returns a BackendGrantInformer.
+	BackendGrants() BackendGrantInformer
 	// Certificates returns a CertificateInformer.
 	Certificates() CertificateInformer
 	// ClusterDomainClaims returns a ClusterDomainClaimInformer.
@@ -45,6 +47,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// BackendGrants returns a BackendGrantInformer.
+func (v *version) BackendGrants() BackendGrantInformer {
+	return &backendGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Certificates returns a CertificateInformer.
 func (v *version) Certificates() CertificateInformer {
 	return &certificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	networkingv1alpha1 "knative.dev/networking/pkg/apis/networking/v1alpha1"
+)
+
+// BackendGrantLister helps list BackendGrants.
+// All objects returned here must be treated as read-only.
+type BackendGrantLister interface {
+	// List lists all BackendGrants in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*networkingv1alpha1.BackendGrant, err error)
+	// BackendGrants returns an object that can list and get BackendGrants.
+	BackendGrants(namespace string) BackendGrantNamespaceLister
+	BackendGrantListerExpansion
+}
+
+// backendGrantLister implements the BackendGrantLister interface.
+type backendGrantLister struct {
+	listers.ResourceIndexer[*networkingv1alpha1.BackendGrant]
+}
+
+// NewBackendGrantLister returns a new BackendGrantLister.
+func NewBackendGrantLister(indexer cache.Indexer) BackendGrantLister {
+	return &backendGrantLister{listers.New[*networkingv1alpha1.BackendGrant](indexer, networkingv1alpha1.Resource("backendgrant"))}
+}
+
+// BackendGrants returns an object that can list and get BackendGrants.
+func (s *backendGrantLister) BackendGrants(namespace string) BackendGrantNamespaceLister {
+	return backendGrantNamespaceLister{listers.NewNamespaced[*networkingv1alpha1.BackendGrant](s.ResourceIndexer, namespace)}
+}
+
+// BackendGrantNamespaceLister helps list and get BackendGrants.
+// All objects returned here must be treated as read-only.
+type BackendGrantNamespaceLister interface {
+	// List lists all BackendGrants in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*networkingv1alpha1.BackendGrant, err error)
+	// Get retrieves the BackendGrant from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*networkingv1alpha1.BackendGrant, error)
+	BackendGrantNamespaceListerExpansion
+}
+
+// backendGrantNamespaceLister implements the BackendGrantNamespaceLister
+// interface.
+type backendGrantNamespaceLister struct {
+	listers.ResourceIndexer[*networkingv1alpha1.BackendGrant]
+}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+// BackendGrantListerExpansion allows custom methods to be added to
+// BackendGrantLister.
+type BackendGrantListerExpansion interface{}
+
+// BackendGrantNamespaceListerExpansion allows custom methods to be added to
+// BackendGrantNamespaceLister.
+type BackendGrantNamespaceListerExpansion interface{}
+
 // CertificateListerExpansion allows custom methods to be added to
 // CertificateLister.
 type CertificateListerExpansion interface{}
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -30,6 +32,8 @@ import (
 	"k8s.io/utils/lru"
 	cm "knative.dev/pkg/configmap"
 	"sigs.k8s.io/yaml"
+
+	"knative.dev/networking/pkg/validation"
 )
 
 var (
@@ -115,6 +119,42 @@ const (
 	// specifies the HTTP endpoint behavior of Knative ingress.
 	HTTPProtocolKey = "http-protocol"
 
+	// HTTP3Key is the name of the configuration entry that
+	// specifies whether Ingresses serve HTTP/3 by default.
+	HTTP3Key = "http3"
+
+	// HSTSMaxAgeKey is the name of the configuration entry that specifies
+	// the max-age, in seconds, of the default HSTS policy of Ingresses.
+	HSTSMaxAgeKey = "hsts-max-age"
+
+	// HSTSIncludeSubDomainsKey is the name of the configuration entry that
+	// specifies whether the default HSTS policy applies to subdomains.
+	HSTSIncludeSubDomainsKey = "hsts-include-subdomains"
+
+	// HSTSPreloadKey is the name of the configuration entry that specifies
+	// whether the default HSTS policy signals consent to preloading.
+	HSTSPreloadKey = "hsts-preload"
+
+	// AccessLogEnabledKey is the name of the configuration entry that
+	// specifies whether Ingresses log their requests by default.
+	AccessLogEnabledKey = "access-log-enabled"
+
+	// AccessLogFieldsKey is the name of the configuration entry that specifies
+	// the default comma-separated fields of the JSON access log entries.
+	AccessLogFieldsKey = "access-log-fields"
+
+	// AccessLogSamplingFractionKey is the name of the configuration entry that
+	// specifies the default fraction of the requests to log.
+	AccessLogSamplingFractionKey = "access-log-sampling-fraction"
+
+	// TraceSamplingRateKey is the name of the configuration entry that
+	// specifies the default fraction of the requests to trace.
+	TraceSamplingRateKey = "trace-sampling-rate"
+
+	// ReservedListenerPortsKey is the name of the configuration entry that
+	// specifies the comma-separated ports Ingresses may not listen on.
+	ReservedListenerPortsKey = "reserved-listener-ports"
+
 	// MeshCompatibilityModeKey is the config for selecting the mesh compatibility mode.
 	MeshCompatibilityModeKey = "mesh-compatibility-mode"
 
@@ -183,6 +223,36 @@ const (
 	HTTPRedirected HTTPProtocol = "redirected"
 )
 
+// HSTSConfig is the HTTP Strict Transport Security policy
+// advertised by the Ingresses that don't set one.
+type HSTSConfig struct {
+	// MaxAgeSeconds is the max-age directive of the policy.
+	MaxAgeSeconds int64
+
+	// IncludeSubDomains specifies whether the includeSubDomains directive is set.
+	IncludeSubDomains bool
+
+	// Preload specifies whether the preload directive is set.
+	Preload bool
+}
+
+// ObservabilityConfig is the access logging and tracing
+// configuration of the traffic served by an Ingress.
+type ObservabilityConfig struct {
+	// AccessLogEnabled specifies whether the requests are logged.
+	AccessLogEnabled bool
+
+	// AccessLogFields are the fields of the JSON access log entries.
+	// The Ingress implementation picks them when empty.
+	AccessLogFields []string
+
+	// AccessLogSamplingFraction is the fraction of the requests to log.
+	AccessLogSamplingFraction float64
+
+	// TraceSamplingRate is the fraction of the requests to trace.
+	TraceSamplingRate float64
+}
+
 // MeshCompatibilityMode is one of enabled (always use ClusterIP), disabled
 // (always use Pod IP), or auto (try PodIP, and fall back to ClusterIP if mesh
 // is detected).
@@ -253,6 +323,23 @@ type Config struct {
 	// ingress.
 	HTTPProtocol HTTPProtocol
 
+	// HTTP3 specifies whether HTTP/3 is served on the TLS listeners of
+	// the Ingresses that don't set it explicitly.
+	HTTP3 bool
+
+	// HSTS specifies the HSTS policy of the Ingresses with TLS that
+	// don't set one. HSTS is disabled by default when it is nil.
+	HSTS *HSTSConfig
+
+	// Observability specifies the access logging and tracing configuration
+	// of the Ingresses, unless overridden by their annotations or spec.
+	Observability ObservabilityConfig
+
+	// ReservedListenerPorts are the ports that the gateways use for their own
+	// administration, health and metrics endpoints, which the listeners of
+	// the Ingresses may not use.
+	ReservedListenerPorts []int32
+
 	// DefaultCertificateClass specifies the default Certificate class.
 	DefaultCertificateClass string
 
@@ -317,6 +404,19 @@ func defaultConfig() *Config {
 		ExternalDomainTLS:             false,
 		NamespaceWildcardCertSelector: nil,
 		HTTPProtocol:                  HTTPEnabled,
+		HTTP3:                         false,
+		Observability: ObservabilityConfig{
+			AccessLogEnabled:          false,
+			AccessLogSamplingFraction: 1,
+			TraceSamplingRate:         0,
+		},
+		ReservedListenerPorts: []int32{
+			9901,  // Envoy admin
+			15000, // Envoy admin (Istio)
+			15020, // Istio agent
+			15021, // Istio health
+			15090, // Envoy metrics (Istio)
+		},
 		AutocreateClusterDomainClaims: false,
 		DefaultExternalScheme:         "http",
 		MeshCompatibilityMode:         MeshCompatibilityModeAuto,
@@ -360,10 +460,25 @@ func NewConfigFromMap(data map[string]string) (*Config, error) {
 		cm.AsBool(InternalEncryptionKey, &nc.InternalEncryption),
 		asMode(MeshCompatibilityModeKey, &nc.MeshCompatibilityMode),
 		asLabelSelector(NamespaceWildcardCertSelectorKey, &nc.NamespaceWildcardCertSelector),
+		cm.AsBool(AccessLogEnabledKey, &nc.Observability.AccessLogEnabled),
+		asFields(AccessLogFieldsKey, &nc.Observability.AccessLogFields),
+		cm.AsFloat64(AccessLogSamplingFractionKey, &nc.Observability.AccessLogSamplingFraction),
+		cm.AsFloat64(TraceSamplingRateKey, &nc.Observability.TraceSamplingRate),
+		asPorts(ReservedListenerPortsKey, &nc.ReservedListenerPorts),
 	); err != nil {
 		return nil, err
 	}
 
+	if err := validation.AccessLogFields(nc.Observability.AccessLogFields); err != nil {
+		return nil, fmt.Errorf("invalid %s in config-network ConfigMap: %w", AccessLogFieldsKey, err)
+	}
+	if f := nc.Observability.AccessLogSamplingFraction; f < 0 || f > 1 {
+		return nil, fmt.Errorf("%s must be between 0 and 1, but was %v", AccessLogSamplingFractionKey, f)
+	}
+	if f := nc.Observability.TraceSamplingRate; f < 0 || f > 1 {
+		return nil, fmt.Errorf("%s must be between 0 and 1, but was %v", TraceSamplingRateKey, f)
+	}
+
 	if nc.RolloutDurationSecs < 0 {
 		return nil, fmt.Errorf("%s must be a positive integer, but was %d", RolloutDurationKey, nc.RolloutDurationSecs)
 	}
@@ -425,6 +540,34 @@ func NewConfigFromMap(data map[string]string) (*Config, error) {
 		return nil, fmt.Errorf("httpProtocol %s in config-network ConfigMap is not supported", data[HTTPProtocolKey])
 	}
 
+	switch strings.ToLower(data[HTTP3Key]) {
+	case "", "disabled":
+		// If HTTP3Key is not set in the config-network, default is already
+		// set to false.
+	case "enabled":
+		nc.HTTP3 = true
+	default:
+		return nil, fmt.Errorf("%s with value: %q in config-network ConfigMap is not supported",
+			HTTP3Key, data[HTTP3Key])
+	}
+
+	if raw := data[HSTSMaxAgeKey]; raw != "" {
+		maxAge, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || maxAge < 0 {
+			return nil, fmt.Errorf("%s must be a non-negative integer, but was %q", HSTSMaxAgeKey, raw)
+		}
+		nc.HSTS = &HSTSConfig{MaxAgeSeconds: maxAge}
+		if err := cm.Parse(data,
+			cm.AsBool(HSTSIncludeSubDomainsKey, &nc.HSTS.IncludeSubDomains),
+			cm.AsBool(HSTSPreloadKey, &nc.HSTS.Preload),
+		); err != nil {
+			return nil, err
+		}
+		if err := validation.HSTSPolicy(nc.HSTS.MaxAgeSeconds, nc.HSTS.IncludeSubDomains, nc.HSTS.Preload); err != nil {
+			return nil, fmt.Errorf("invalid HSTS policy in config-network ConfigMap: %w", err)
+		}
+	}
+
 	switch strings.ToLower(data[SystemInternalTLSKey]) {
 	case "", string(EncryptionDisabled):
 		// If SystemInternalTLSKey is not set in the config-network, default is already
@@ -458,6 +601,12 @@ func NewConfigFromMap(data map[string]string) (*Config, error) {
 	return nc, nil
 }
 
+// IsReservedListenerPort returns true if the given port may not be
+// used as the listener port of an Ingress.
+func (c *Config) IsReservedListenerPort(port int32) bool {
+	return slices.Contains(c.ReservedListenerPorts, port)
+}
+
 // InternalTLSEnabled returns whether InternalEncryption is enabled or not.
 // Deprecated: please use SystemInternalTLSEnabled()
 func (c *Config) InternalTLSEnabled() bool {
@@ -556,6 +705,44 @@ func asLabelSelector(key string, target **metav1.LabelSelector) cm.ParseFunc {
 	}
 }
 
+// asFields parses the comma-separated value at key into the target, if it exists.
+// Empty entries are dropped, leaving the target nil if there are none.
+func asFields(key string, target *[]string) cm.ParseFunc {
+	return func(data map[string]string) error {
+		if raw, ok := data[key]; ok {
+			var fields []string
+			for _, field := range strings.Split(raw, ",") {
+				if field = strings.TrimSpace(field); field != "" {
+					fields = append(fields, field)
+				}
+			}
+			*target = fields
+		}
+		return nil
+	}
+}
+
+// asPorts parses the comma-separated ports at key into the target, if it exists.
+func asPorts(key string, target *[]int32) cm.ParseFunc {
+	return func(data map[string]string) error {
+		if raw, ok := data[key]; ok {
+			var ports []int32
+			for _, field := range strings.Split(raw, ",") {
+				if field = strings.TrimSpace(field); field == "" {
+					continue
+				}
+				port, err := strconv.ParseInt(field, 10, 32)
+				if err != nil || port < 1 || port > 65535 {
+					return fmt.Errorf("%s must be a list of ports between 1 and 65535, but contained %q", key, field)
+				}
+				ports = append(ports, int32(port))
+			}
+			*target = ports
+		}
+		return nil
+	}
+}
+
 // asMode parses the value at key as a MeshCompatibilityMode into the target, if it exists.
 func asMode(key string, target *MeshCompatibilityMode) cm.ParseFunc {
 	return func(data map[string]string) error {
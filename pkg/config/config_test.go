@@ -271,6 +271,133 @@ func TestConfiguration(t *testing.T) {
 			HTTPProtocolKey: "under-the-bridge",
 		},
 		wantErr: true,
+	}, {
+		name: "network configuration with HTTP3 enabled",
+		data: map[string]string{
+			HTTP3Key: "Enabled",
+		},
+		wantConfig: func() *Config {
+			c := defaultConfig()
+			c.HTTP3 = true
+			return c
+		}(),
+	}, {
+		name: "network configuration with HTTP3 bad",
+		data: map[string]string{
+			HTTP3Key: "h3",
+		},
+		wantErr: true,
+	}, {
+		name: "network configuration with HSTS",
+		data: map[string]string{
+			HSTSMaxAgeKey:            "31536000",
+			HSTSIncludeSubDomainsKey: "true",
+			HSTSPreloadKey:           "true",
+		},
+		wantConfig: func() *Config {
+			c := defaultConfig()
+			c.HSTS = &HSTSConfig{
+				MaxAgeSeconds:     31536000,
+				IncludeSubDomains: true,
+				Preload:           true,
+			}
+			return c
+		}(),
+	}, {
+		name: "network configuration with HSTS directives without max-age",
+		data: map[string]string{
+			HSTSIncludeSubDomainsKey: "true",
+		},
+		wantConfig: defaultConfig(),
+	}, {
+		name: "network configuration with HSTS bad max-age",
+		data: map[string]string{
+			HSTSMaxAgeKey: "-1",
+		},
+		wantErr: true,
+	}, {
+		name: "network configuration with HSTS preload without includeSubDomains",
+		data: map[string]string{
+			HSTSMaxAgeKey:  "31536000",
+			HSTSPreloadKey: "true",
+		},
+		wantErr: true,
+	}, {
+		name: "network configuration with HSTS preload and short max-age",
+		data: map[string]string{
+			HSTSMaxAgeKey:            "86400",
+			HSTSIncludeSubDomainsKey: "true",
+			HSTSPreloadKey:           "true",
+		},
+		wantErr: true,
+	}, {
+		name: "network configuration with observability",
+		data: map[string]string{
+			AccessLogEnabledKey:          "true",
+			AccessLogFieldsKey:           "method, path,,status",
+			AccessLogSamplingFractionKey: "0.5",
+			TraceSamplingRateKey:         "0.01",
+		},
+		wantConfig: func() *Config {
+			c := defaultConfig()
+			c.Observability = ObservabilityConfig{
+				AccessLogEnabled:          true,
+				AccessLogFields:           []string{"method", "path", "status"},
+				AccessLogSamplingFraction: 0.5,
+				TraceSamplingRate:         0.01,
+			}
+			return c
+		}(),
+	}, {
+		name: "network configuration with unsupported access log field",
+		data: map[string]string{
+			AccessLogFieldsKey: "method,referer",
+		},
+		wantErr: true,
+	}, {
+		name: "network configuration with duplicate access log field",
+		data: map[string]string{
+			AccessLogFieldsKey: "method,path,method",
+		},
+		wantErr: true,
+	}, {
+		name: "network configuration with bad access log sampling fraction",
+		data: map[string]string{
+			AccessLogSamplingFractionKey: "2",
+		},
+		wantErr: true,
+	}, {
+		name: "network configuration with bad trace sampling rate",
+		data: map[string]string{
+			TraceSamplingRateKey: "sometimes",
+		},
+		wantErr: true,
+	}, {
+		name: "network configuration with reserved listener ports",
+		data: map[string]string{
+			ReservedListenerPortsKey: "9901, 15021,",
+		},
+		wantConfig: func() *Config {
+			c := defaultConfig()
+			c.ReservedListenerPorts = []int32{9901, 15021}
+			return c
+		}(),
+	}, {
+		name: "network configuration without reserved listener ports",
+		data: map[string]string{
+			ReservedListenerPortsKey: "",
+		},
+		wantConfig: func() *Config {
+			c := defaultConfig()
+			c.ReservedListenerPorts = nil
+			return c
+		}(),
+	}, {
+		name: "network configuration with bad reserved listener port",
+		data: map[string]string{
+			ReservedListenerPortsKey: "9901,70000",
+		},
+		wantErr: true,
 	}, {
 		name: "network configuration with enabled pod-addressability",
 		data: map[string]string{
@@ -422,6 +549,10 @@ func TestConfiguration(t *testing.T) {
 			MeshCompatibilityMode: MeshCompatibilityModeAuto,
 			SystemInternalTLS:     EncryptionDisabled,
 			ClusterLocalDomainTLS: EncryptionDisabled,
+			Observability: ObservabilityConfig{
+				AccessLogSamplingFraction: 1,
+			},
+			ReservedListenerPorts: defaultConfig().ReservedListenerPorts,
 		},
 	}, {
 		name: "newer keys take precedence over legacy keys",
@@ -465,6 +596,10 @@ func TestConfiguration(t *testing.T) {
 			MeshCompatibilityMode: MeshCompatibilityModeAuto,
 			SystemInternalTLS:     EncryptionDisabled,
 			ClusterLocalDomainTLS: EncryptionDisabled,
+			Observability: ObservabilityConfig{
+				AccessLogSamplingFraction: 1,
+			},
+			ReservedListenerPorts: defaultConfig().ReservedListenerPorts,
 		},
 	}}
 
@@ -497,6 +632,20 @@ func TestConfiguration(t *testing.T) {
 	}
 }
 
+func TestIsReservedListenerPort(t *testing.T) {
+	c := defaultConfig()
+	for port, want := range map[int32]bool{
+		80:    false,
+		443:   false,
+		9901:  true,
+		15021: true,
+	} {
+		if got := c.IsReservedListenerPort(port); got != want {
+			t.Errorf("IsReservedListenerPort(%d) = %v, wanted %v", port, got, want)
+		}
+	}
+}
+
 func TestTemplateCaching(t *testing.T) {
 	// Reset the template cache, to ensure size change.
 	templateCache = lru.New(10)
@@ -28,6 +28,17 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Config) DeepCopyInto(out *Config) {
 	*out = *in
+	if in.HSTS != nil {
+		in, out := &in.HSTS, &out.HSTS
+		*out = new(HSTSConfig)
+		**out = **in
+	}
+	in.Observability.DeepCopyInto(&out.Observability)
+	if in.ReservedListenerPorts != nil {
+		in, out := &in.ReservedListenerPorts, &out.ReservedListenerPorts
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
 	if in.NamespaceWildcardCertSelector != nil {
 		in, out := &in.NamespaceWildcardCertSelector, &out.NamespaceWildcardCertSelector
 		*out = new(v1.LabelSelector)
@@ -76,6 +87,43 @@ func (in *DomainTemplateValues) DeepCopy() *DomainTemplateValues {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HSTSConfig) DeepCopyInto(out *HSTSConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HSTSConfig.
+func (in *HSTSConfig) DeepCopy() *HSTSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HSTSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
+	*out = *in
+	if in.AccessLogFields != nil {
+		in, out := &in.AccessLogFields, &out.AccessLogFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityConfig.
+func (in *ObservabilityConfig) DeepCopy() *ObservabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TagTemplateValues) DeepCopyInto(out *TagTemplateValues) {
 	*out = *in
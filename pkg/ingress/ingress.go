@@ -21,10 +21,16 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/networking/pkg/apis/networking"
 	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	listers "knative.dev/networking/pkg/client/listers/networking/v1alpha1"
+	"knative.dev/networking/pkg/config"
 	"knative.dev/networking/pkg/http/header"
 	"knative.dev/pkg/network"
 )
@@ -42,6 +48,8 @@ func ComputeHash(ing *v1alpha1.Ingress) ([sha256.Size]byte, error) {
 
 // InsertProbe adds a AppendHeader rule so that any request going through a Gateway is tagged with
 // the version of the Ingress currently deployed on the Gateway.
+// The probe paths are added to each rule, so they are served on the listener port of the rule,
+// which is to be used as the ProbeTarget listener port when probing the hosts of the rule.
 func InsertProbe(ing *v1alpha1.Ingress) (string, error) {
 	bytes, err := ComputeHash(ing)
 	if err != nil {
@@ -50,6 +58,11 @@ func InsertProbe(ing *v1alpha1.Ingress) (string, error) {
 	hash := hex.EncodeToString(bytes[:])
 
 	for _, rule := range ing.Spec.Rules {
+		if rule.TLSPassthrough != nil {
+			// Passthrough connections are not terminated by the Gateway,
+			// so there is no request to tag.
+			continue
+		}
 		if rule.HTTP == nil {
 			return "", fmt.Errorf("rule is missing HTTP block: %+v", rule)
 		}
@@ -72,10 +85,110 @@ func InsertProbe(ing *v1alpha1.Ingress) (string, error) {
 	return hash, nil
 }
 
+// ProbePort returns the port to use as ProbeTarget listener port when probing the hosts of the
+// given rule, over TLS with the given settings if tls is not nil, over plain HTTP otherwise.
+func ProbePort(rule *v1alpha1.IngressRule, tls *v1alpha1.IngressTLS) string {
+	switch {
+	case tls != nil && tls.Port != 0:
+		return strconv.Itoa(int(tls.Port))
+	case tls != nil:
+		return strconv.Itoa(int(v1alpha1.DefaultHTTPSPort))
+	case rule.Port != 0:
+		return strconv.Itoa(int(rule.Port))
+	default:
+		return strconv.Itoa(int(v1alpha1.DefaultHTTPPort))
+	}
+}
+
+// HTTP3Enabled returns whether HTTP/3 is to be served on the TLS listeners of the
+// given Ingress, falling back to the config-network default when the Ingress
+// doesn't set it explicitly.
+func HTTP3Enabled(ing *v1alpha1.Ingress, cfg *config.Config) bool {
+	switch ing.Spec.HTTP3 {
+	case v1alpha1.HTTP3OptionEnabled:
+		return true
+	case v1alpha1.HTTP3OptionDisabled:
+		return false
+	default:
+		return cfg != nil && cfg.HTTP3 && len(ing.Spec.TLS) > 0
+	}
+}
+
+// HSTSPolicy returns the HSTS policy to advertise on the TLS listeners of the given
+// Ingress, falling back to the config-network default when the Ingress doesn't set
+// one explicitly. It returns nil when no Strict-Transport-Security header is to be set.
+func HSTSPolicy(ing *v1alpha1.Ingress, cfg *config.Config) *v1alpha1.HSTSPolicy {
+	switch {
+	case ing.Spec.HSTS != nil:
+		return ing.Spec.HSTS
+	case cfg == nil || cfg.HSTS == nil || len(ing.Spec.TLS) == 0:
+		return nil
+	default:
+		return &v1alpha1.HSTSPolicy{
+			MaxAge:            cfg.HSTS.MaxAgeSeconds,
+			IncludeSubDomains: cfg.HSTS.IncludeSubDomains,
+			Preload:           cfg.HSTS.Preload,
+		}
+	}
+}
+
+// Observability returns the access logging and tracing configuration of the given
+// Ingress. The config-network defaults are overridden by the annotations of the Ingress,
+// which are overridden by its spec.observability block. Malformed annotations are ignored.
+func Observability(ing *v1alpha1.Ingress, cfg *config.Config) config.ObservabilityConfig {
+	obs := config.ObservabilityConfig{AccessLogSamplingFraction: 1}
+	if cfg != nil {
+		obs = cfg.Observability
+		obs.AccessLogFields = slices.Clone(obs.AccessLogFields)
+	}
+
+	switch strings.ToLower(networking.GetAccessLog(ing.Annotations)) {
+	case "enabled":
+		obs.AccessLogEnabled = true
+	case "disabled":
+		obs.AccessLogEnabled = false
+	}
+	if rate, ok := parseFraction(networking.GetTraceSamplingRate(ing.Annotations)); ok {
+		obs.TraceSamplingRate = rate
+	}
+
+	if spec := ing.Spec.Observability; spec != nil {
+		if al := spec.AccessLog; al != nil {
+			if al.Enabled != nil {
+				obs.AccessLogEnabled = *al.Enabled
+			}
+			if len(al.Fields) > 0 {
+				obs.AccessLogFields = slices.Clone(al.Fields)
+			}
+			if fraction, ok := parseFraction(al.SamplingFraction); ok {
+				obs.AccessLogSamplingFraction = fraction
+			}
+		}
+		if spec.Tracing != nil {
+			if rate, ok := parseFraction(spec.Tracing.SamplingRate); ok {
+				obs.TraceSamplingRate = rate
+			}
+		}
+	}
+	return obs
+}
+
+// parseFraction parses the given value as a decimal number between 0 and 1.
+func parseFraction(value string) (float64, bool) {
+	if value == "" {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f < 0 || f > 1 {
+		return 0, false
+	}
+	return f, true
+}
+
 // HostsPerVisibility takes an Ingress and a map from visibility levels to a set of string keys,
 // it then returns a map from that key space to the hosts under that visibility.
 func HostsPerVisibility(ing *v1alpha1.Ingress, visibilityToKey map[v1alpha1.IngressVisibility]sets.Set[string]) map[string]sets.Set[string] {
-	output := make(map[string]sets.Set[string], 2) // We currently have public and internal.
+	output := make(map[string]sets.Set[string], 3) // We currently have public, cluster-local and internal.
 	for _, rule := range ing.Spec.Rules {
 		for host := range ExpandedHosts(sets.New(rule.Hosts...)) {
 			for key := range visibilityToKey[rule.Visibility] {
@@ -89,6 +202,53 @@ func HostsPerVisibility(ing *v1alpha1.Ingress, visibilityToKey map[v1alpha1.Ingr
 	return output
 }
 
+// CheckBackendGrants verifies that every backend of the Ingress living in a
+// different namespace than the Ingress is permitted by a BackendGrant in the
+// namespace of that backend. It returns an error naming the first backend that
+// is not permitted.
+func CheckBackendGrants(ing *v1alpha1.Ingress, grantLister listers.BackendGrantLister) error {
+	for _, rule := range ing.Spec.Rules {
+		if rule.TLSPassthrough != nil {
+			if err := checkBackendGrant(ing, rule.TLSPassthrough.Backend, grantLister); err != nil {
+				return err
+			}
+		}
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			for _, split := range path.Splits {
+				if err := checkBackendGrant(ing, split.IngressBackend, grantLister); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, page := range ing.Spec.ErrorPages {
+		if page.Backend != nil {
+			if err := checkBackendGrant(ing, *page.Backend, grantLister); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkBackendGrant(ing *v1alpha1.Ingress, backend v1alpha1.IngressBackend, grantLister listers.BackendGrantLister) error {
+	if backend.External != nil || backend.ServiceNamespace == ing.Namespace {
+		return nil
+	}
+	grants, err := grantLister.BackendGrants(backend.ServiceNamespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list BackendGrants in namespace %q: %w", backend.ServiceNamespace, err)
+	}
+	if !v1alpha1.IsBackendPermitted(ing.Namespace, backend, grants) {
+		return fmt.Errorf("no BackendGrant in namespace %q permits references from namespace %q to service %q",
+			backend.ServiceNamespace, ing.Namespace, backend.ServiceName)
+	}
+	return nil
+}
+
 // ExpandedHosts sets up hosts for the short-names for cluster DNS names.
 func ExpandedHosts(hosts sets.Set[string]) sets.Set[string] {
 	allowedSuffixes := []string{
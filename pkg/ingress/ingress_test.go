@@ -21,8 +21,14 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/networking/pkg/apis/networking"
 	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	listers "knative.dev/networking/pkg/client/listers/networking/v1alpha1"
+	"knative.dev/networking/pkg/config"
+	"knative.dev/pkg/ptr"
 )
 
 func TestGetExpandedHosts(t *testing.T) {
@@ -145,6 +151,36 @@ func TestInsertProbe(t *testing.T) {
 			},
 		},
 		want: "6b652c7abed871354affd4a9cb699d33816f24541fac942149b91ad872fe63ca",
+	}, {
+		name: "with tls passthrough rule",
+		ingress: &v1alpha1.Ingress{
+			Spec: v1alpha1.IngressSpec{
+				Rules: []v1alpha1.IngressRule{{
+					Hosts: []string{
+						"example.com",
+					},
+					HTTP: &v1alpha1.HTTPIngressRuleValue{
+						Paths: []v1alpha1.HTTPIngressPath{{
+							Splits: []v1alpha1.IngressBackendSplit{{
+								IngressBackend: v1alpha1.IngressBackend{
+									ServiceName: "blah",
+								},
+							}},
+						}},
+					},
+				}, {
+					Hosts: []string{
+						"db.example.com",
+					},
+					TLSPassthrough: &v1alpha1.TLSPassthroughRuleValue{
+						Backend: v1alpha1.IngressBackend{
+							ServiceName: "db",
+						},
+					},
+				}},
+			},
+		},
+		want: "d9c3e76eadc0188f39f08cb2e3a405db9fdbef23463ba9e98e005ac4072d7d81",
 	}, {
 		name: "rule missing HTTP block",
 		ingress: &v1alpha1.Ingress{
@@ -204,6 +240,227 @@ func TestInsertProbe(t *testing.T) {
 	}
 }
 
+func TestProbePort(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *v1alpha1.IngressRule
+		tls  *v1alpha1.IngressTLS
+		want string
+	}{{
+		name: "default http port",
+		rule: &v1alpha1.IngressRule{},
+		want: "80",
+	}, {
+		name: "custom http port",
+		rule: &v1alpha1.IngressRule{Port: 8080},
+		want: "8080",
+	}, {
+		name: "default https port",
+		rule: &v1alpha1.IngressRule{Port: 8080},
+		tls:  &v1alpha1.IngressTLS{},
+		want: "443",
+	}, {
+		name: "custom https port",
+		rule: &v1alpha1.IngressRule{Port: 8080},
+		tls:  &v1alpha1.IngressTLS{Port: 8443},
+		want: "8443",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ProbePort(test.rule, test.tls); got != test.want {
+				t.Errorf("ProbePort() = %s, wanted %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHTTP3Enabled(t *testing.T) {
+	tls := []v1alpha1.IngressTLS{{SecretNamespace: "default", SecretName: "secret"}}
+	enabled := &config.Config{HTTP3: true}
+	disabled := &config.Config{}
+
+	tests := []struct {
+		name string
+		spec v1alpha1.IngressSpec
+		cfg  *config.Config
+		want bool
+	}{{
+		name: "default disabled",
+		spec: v1alpha1.IngressSpec{TLS: tls},
+		cfg:  disabled,
+	}, {
+		name: "default enabled",
+		spec: v1alpha1.IngressSpec{TLS: tls},
+		cfg:  enabled,
+		want: true,
+	}, {
+		name: "default enabled without tls",
+		cfg:  enabled,
+	}, {
+		name: "no config",
+		spec: v1alpha1.IngressSpec{TLS: tls},
+	}, {
+		name: "enabled on the ingress",
+		spec: v1alpha1.IngressSpec{TLS: tls, HTTP3: v1alpha1.HTTP3OptionEnabled},
+		cfg:  disabled,
+		want: true,
+	}, {
+		name: "disabled on the ingress",
+		spec: v1alpha1.IngressSpec{TLS: tls, HTTP3: v1alpha1.HTTP3OptionDisabled},
+		cfg:  enabled,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ing := &v1alpha1.Ingress{Spec: test.spec}
+			if got := HTTP3Enabled(ing, test.cfg); got != test.want {
+				t.Errorf("HTTP3Enabled() = %t, wanted %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHSTSPolicy(t *testing.T) {
+	tls := []v1alpha1.IngressTLS{{SecretNamespace: "default", SecretName: "secret"}}
+	cfg := &config.Config{
+		HSTS: &config.HSTSConfig{
+			MaxAgeSeconds:     300,
+			IncludeSubDomains: true,
+		},
+	}
+
+	tests := []struct {
+		name string
+		spec v1alpha1.IngressSpec
+		cfg  *config.Config
+		want *v1alpha1.HSTSPolicy
+	}{{
+		name: "disabled by default",
+		spec: v1alpha1.IngressSpec{TLS: tls},
+		cfg:  &config.Config{},
+	}, {
+		name: "no config",
+		spec: v1alpha1.IngressSpec{TLS: tls},
+	}, {
+		name: "default policy",
+		spec: v1alpha1.IngressSpec{TLS: tls},
+		cfg:  cfg,
+		want: &v1alpha1.HSTSPolicy{MaxAge: 300, IncludeSubDomains: true},
+	}, {
+		name: "default policy without tls",
+		cfg:  cfg,
+	}, {
+		name: "ingress policy",
+		spec: v1alpha1.IngressSpec{TLS: tls, HSTS: &v1alpha1.HSTSPolicy{MaxAge: 60}},
+		cfg:  cfg,
+		want: &v1alpha1.HSTSPolicy{MaxAge: 60},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ing := &v1alpha1.Ingress{Spec: test.spec}
+			if got := HSTSPolicy(ing, test.cfg); !cmp.Equal(got, test.want) {
+				t.Errorf("HSTSPolicy (-want, +got) = \n%s", cmp.Diff(test.want, got))
+			}
+		})
+	}
+}
+
+func TestObservability(t *testing.T) {
+	cfg := &config.Config{
+		Observability: config.ObservabilityConfig{
+			AccessLogFields:           []string{"method", "path"},
+			AccessLogSamplingFraction: 1,
+			TraceSamplingRate:         0.01,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		spec        *v1alpha1.IngressObservability
+		cfg         *config.Config
+		want        config.ObservabilityConfig
+	}{{
+		name: "no config",
+		want: config.ObservabilityConfig{AccessLogSamplingFraction: 1},
+	}, {
+		name: "defaults",
+		cfg:  cfg,
+		want: cfg.Observability,
+	}, {
+		name: "annotations",
+		annotations: map[string]string{
+			networking.AccessLogAnnotationKey:         "Enabled",
+			networking.TraceSamplingRateAnnotationKey: "0.5",
+		},
+		cfg: cfg,
+		want: config.ObservabilityConfig{
+			AccessLogEnabled:          true,
+			AccessLogFields:           []string{"method", "path"},
+			AccessLogSamplingFraction: 1,
+			TraceSamplingRate:         0.5,
+		},
+	}, {
+		name: "malformed annotations",
+		annotations: map[string]string{
+			networking.AccessLogAnnotationKey:         "maybe",
+			networking.TraceSamplingRateAnnotationKey: "2",
+		},
+		cfg:  cfg,
+		want: cfg.Observability,
+	}, {
+		name: "spec takes precedence",
+		annotations: map[string]string{
+			networking.AccessLogAnnotationKey:         "enabled",
+			networking.TraceSamplingRateAnnotationKey: "0.5",
+		},
+		spec: &v1alpha1.IngressObservability{
+			AccessLog: &v1alpha1.IngressAccessLog{
+				Enabled:          ptr.Bool(false),
+				Fields:           []string{"status"},
+				SamplingFraction: "0.1",
+			},
+			Tracing: &v1alpha1.IngressTracing{
+				SamplingRate: "1",
+			},
+		},
+		cfg: cfg,
+		want: config.ObservabilityConfig{
+			AccessLogFields:           []string{"status"},
+			AccessLogSamplingFraction: 0.1,
+			TraceSamplingRate:         1,
+		},
+	}, {
+		name: "partial spec",
+		spec: &v1alpha1.IngressObservability{
+			AccessLog: &v1alpha1.IngressAccessLog{
+				Enabled: ptr.Bool(true),
+			},
+		},
+		cfg: cfg,
+		want: config.ObservabilityConfig{
+			AccessLogEnabled:          true,
+			AccessLogFields:           []string{"method", "path"},
+			AccessLogSamplingFraction: 1,
+			TraceSamplingRate:         0.01,
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ing := &v1alpha1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations},
+				Spec:       v1alpha1.IngressSpec{Observability: test.spec},
+			}
+			if got := Observability(ing, test.cfg); !cmp.Equal(got, test.want) {
+				t.Errorf("Observability (-want, +got) = \n%s", cmp.Diff(test.want, got))
+			}
+		})
+	}
+}
+
 func TestHostsPerVisibility(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -287,6 +544,37 @@ func TestHostsPerVisibility(t *testing.T) {
 				"foo.bar",
 			),
 		},
+	}, {
+		name: "rules of all visibilities",
+		ingress: &v1alpha1.Ingress{
+			Spec: v1alpha1.IngressSpec{
+				Rules: []v1alpha1.IngressRule{{
+					Hosts:      []string{"example.com"},
+					Visibility: v1alpha1.IngressVisibilityExternalIP,
+				}, {
+					Hosts:      []string{"foo.bar.svc.cluster.local"},
+					Visibility: v1alpha1.IngressVisibilityClusterLocal,
+				}, {
+					Hosts:      []string{"corp.example.com"},
+					Visibility: v1alpha1.IngressVisibilityInternalIP,
+				}},
+			},
+		},
+		in: map[v1alpha1.IngressVisibility]sets.Set[string]{
+			v1alpha1.IngressVisibilityExternalIP:   sets.New("public"),
+			v1alpha1.IngressVisibilityClusterLocal: sets.New("local"),
+			v1alpha1.IngressVisibilityInternalIP:   sets.New("internal", "public-internal"),
+		},
+		want: map[string]sets.Set[string]{
+			"public": sets.New("example.com"),
+			"local": sets.New(
+				"foo.bar.svc.cluster.local",
+				"foo.bar.svc",
+				"foo.bar",
+			),
+			"internal":        sets.New("corp.example.com"),
+			"public-internal": sets.New("corp.example.com"),
+		},
 	}}
 
 	for _, test := range tests {
@@ -298,3 +586,103 @@ func TestHostsPerVisibility(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckBackendGrants(t *testing.T) {
+	ingressWithBackends := func(backends ...v1alpha1.IngressBackend) *v1alpha1.Ingress {
+		splits := make([]v1alpha1.IngressBackendSplit, 0, len(backends))
+		for _, b := range backends {
+			splits = append(splits, v1alpha1.IngressBackendSplit{IngressBackend: b})
+		}
+		return &v1alpha1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "tenant",
+				Name:      "ingress",
+			},
+			Spec: v1alpha1.IngressSpec{
+				Rules: []v1alpha1.IngressRule{{
+					Hosts: []string{"example.com"},
+					HTTP: &v1alpha1.HTTPIngressRuleValue{
+						Paths: []v1alpha1.HTTPIngressPath{{
+							Splits: splits,
+						}},
+					},
+				}},
+			},
+		}
+	}
+	ingressWithPassthrough := func(backend v1alpha1.IngressBackend) *v1alpha1.Ingress {
+		ing := ingressWithBackends()
+		ing.Spec.Rules = []v1alpha1.IngressRule{{
+			Hosts:          []string{"db.example.com"},
+			TLSPassthrough: &v1alpha1.TLSPassthroughRuleValue{Backend: backend},
+		}}
+		return ing
+	}
+	ingressWithErrorPage := func(backend v1alpha1.IngressBackend) *v1alpha1.Ingress {
+		ing := ingressWithBackends()
+		ing.Spec.ErrorPages = []v1alpha1.ErrorPage{{
+			StatusCodes: v1alpha1.StatusCodeRange{From: 500, To: 599},
+			Backend:     &backend,
+		}}
+		return ing
+	}
+	local := v1alpha1.IngressBackend{ServiceNamespace: "tenant", ServiceName: "app"}
+	auth := v1alpha1.IngressBackend{ServiceNamespace: "platform", ServiceName: "auth"}
+	assets := v1alpha1.IngressBackend{ServiceNamespace: "platform", ServiceName: "assets"}
+	external := v1alpha1.IngressBackend{External: &v1alpha1.ExternalBackend{Host: "api.example.com"}}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	indexer.Add(&v1alpha1.BackendGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "platform",
+			Name:      "auth",
+		},
+		Spec: v1alpha1.BackendGrantSpec{
+			From: []v1alpha1.BackendGrantFrom{{Namespace: "tenant"}},
+			To:   []v1alpha1.BackendGrantTo{{ServiceName: "auth"}},
+		},
+	})
+	lister := listers.NewBackendGrantLister(indexer)
+
+	tests := []struct {
+		name    string
+		ingress *v1alpha1.Ingress
+		wantErr bool
+	}{{
+		name:    "same namespace",
+		ingress: ingressWithBackends(local),
+	}, {
+		name:    "granted backend",
+		ingress: ingressWithBackends(local, auth),
+	}, {
+		name:    "backend without grant",
+		ingress: ingressWithBackends(auth, assets),
+		wantErr: true,
+	}, {
+		name:    "external backend",
+		ingress: ingressWithBackends(local, external),
+	}, {
+		name:    "granted tls passthrough backend",
+		ingress: ingressWithPassthrough(auth),
+	}, {
+		name:    "tls passthrough backend without grant",
+		ingress: ingressWithPassthrough(assets),
+		wantErr: true,
+	}, {
+		name:    "granted error page backend",
+		ingress: ingressWithErrorPage(auth),
+	}, {
+		name:    "error page backend without grant",
+		ingress: ingressWithErrorPage(assets),
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := CheckBackendGrants(test.ingress, lister)
+			if (err != nil) != test.wantErr {
+				t.Errorf("CheckBackendGrants() = %v, wantErr: %v", err, test.wantErr)
+			}
+		})
+	}
+}
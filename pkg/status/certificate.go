@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+)
+
+// CertificateError is the error of the probes whose response was served with a
+// certificate that doesn't match the probed host. Unlike the other probe failures,
+// it usually denotes a misconfiguration rather than a change not programmed yet.
+type CertificateError struct {
+	// Host is the probed host.
+	Host string
+	// Err is the reason why the certificate doesn't match.
+	Err error
+}
+
+// Error implements error.
+func (e *CertificateError) Error() string {
+	return fmt.Sprintf("certificate served for %s doesn't match: %v", e.Host, e.Err)
+}
+
+// Unwrap returns the reason why the certificate doesn't match.
+func (e *CertificateError) Unwrap() error {
+	return e.Err
+}
+
+// verifiesCertificates returns whether the certificates served to the probes are verified.
+func (m *Prober) verifiesCertificates() bool {
+	return m.rootCAs != nil || m.secretLister != nil
+}
+
+// verifyCertificate verifies the certificate served with the response to the probe of
+// the work item, if it was sent over TLS and certificate verification is enabled.
+func (m *Prober) verifyCertificate(item *workItem, r *http.Response) error {
+	if !m.verifiesCertificates() || item.url.Scheme != "https" {
+		return nil
+	}
+	host := item.url.Hostname()
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return &CertificateError{Host: host, Err: errors.New("no certificate was served")}
+	}
+	leaf := r.TLS.PeerCertificates[0]
+
+	if m.rootCAs != nil {
+		intermediates := x509.NewCertPool()
+		for _, cert := range r.TLS.PeerCertificates[1:] {
+			intermediates.AddCert(cert)
+		}
+		if _, err := leaf.Verify(x509.VerifyOptions{
+			DNSName:       host,
+			Roots:         m.rootCAs,
+			Intermediates: intermediates,
+		}); err != nil {
+			return &CertificateError{Host: host, Err: err}
+		}
+	}
+
+	if m.secretLister != nil {
+		tls := ingressTLSFor(item.ingressState.ing, host)
+		if tls == nil {
+			// The host isn't served with a certificate of the Ingress.
+			return nil
+		}
+		want, err := m.secretFingerprint(tls)
+		if err != nil {
+			return err
+		}
+		if got := fingerprint(leaf); got != want {
+			return &CertificateError{Host: host, Err: fmt.Errorf(
+				"the fingerprint of the served certificate is %s, while the one of Secret %s/%s is %s",
+				got, tls.SecretNamespace, tls.SecretName, want)}
+		}
+	}
+	return nil
+}
+
+// ingressTLSFor returns the IngressTLS of the given Ingress covering the given host, if any.
+// An IngressTLS listing the host itself takes precedence over one listing a wildcard
+// covering it, e.g. *.example.com for foo.example.com.
+func ingressTLSFor(ing *v1alpha1.Ingress, host string) *v1alpha1.IngressTLS {
+	for i := range ing.Spec.TLS {
+		if slices.Contains(ing.Spec.TLS[i].Hosts, host) {
+			return &ing.Spec.TLS[i]
+		}
+	}
+	if _, domain, ok := strings.Cut(host, "."); ok {
+		for i := range ing.Spec.TLS {
+			if slices.Contains(ing.Spec.TLS[i].Hosts, "*."+domain) {
+				return &ing.Spec.TLS[i]
+			}
+		}
+	}
+	return nil
+}
+
+// secretFingerprint returns the fingerprint of the leaf certificate of the Secret of the IngressTLS.
+func (m *Prober) secretFingerprint(tls *v1alpha1.IngressTLS) (string, error) {
+	secret, err := m.secretLister.Secrets(tls.SecretNamespace).Get(tls.SecretName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get Secret %s/%s: %w", tls.SecretNamespace, tls.SecretName, err)
+	}
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", fmt.Errorf("no PEM encoded certificate in %q of Secret %s/%s",
+			corev1.TLSCertKey, tls.SecretNamespace, tls.SecretName)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the certificate of Secret %s/%s: %w", tls.SecretNamespace, tls.SecretName, err)
+	}
+	return fingerprint(cert), nil
+}
+
+// fingerprint returns the SHA-256 fingerprint of the certificate.
+func fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
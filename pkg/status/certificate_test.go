@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/pkg/http/header"
+	"knative.dev/networking/pkg/http/probe"
+	"knative.dev/networking/pkg/ingress"
+)
+
+func TestCertificateVerification(t *testing.T) {
+	// The certificate of httptest servers is valid for example.com and *.example.com.
+	const (
+		validHost    = "example.com"
+		wildcardHost = "foo.example.com"
+		invalidHost  = "foo.bar.com"
+		secretName   = "cert"
+	)
+
+	var hash atomic.Value
+	probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set(header.HashKey, hash.Load().(string))
+		probeHandler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+
+	servedRoots := x509.NewCertPool()
+	servedRoots.AddCert(ts.Certificate())
+	otherCert := selfSignedCertificate(t, validHost)
+	otherRoots := x509.NewCertPool()
+	otherRoots.AddCert(otherCert)
+
+	tests := []struct {
+		name       string
+		host       string
+		tlsHosts   []string
+		rootCAs    *x509.CertPool
+		secretCert *x509.Certificate
+		wantReady  bool
+	}{{
+		name:      "no verification",
+		host:      invalidHost,
+		wantReady: true,
+	}, {
+		name:      "trusted certificate",
+		host:      validHost,
+		rootCAs:   servedRoots,
+		wantReady: true,
+	}, {
+		name:    "untrusted certificate",
+		host:    validHost,
+		rootCAs: otherRoots,
+	}, {
+		name:    "certificate not valid for the host",
+		host:    invalidHost,
+		rootCAs: servedRoots,
+	}, {
+		name:       "certificate of the Secret",
+		host:       validHost,
+		tlsHosts:   []string{validHost},
+		secretCert: ts.Certificate(),
+		wantReady:  true,
+	}, {
+		name:       "certificate not of the Secret",
+		host:       validHost,
+		tlsHosts:   []string{validHost},
+		secretCert: otherCert,
+	}, {
+		name:       "certificate of the Secret of a wildcard",
+		host:       wildcardHost,
+		tlsHosts:   []string{"*." + validHost},
+		secretCert: ts.Certificate(),
+		wantReady:  true,
+	}, {
+		name:       "certificate not of the Secret of a wildcard",
+		host:       wildcardHost,
+		tlsHosts:   []string{"*." + validHost},
+		secretCert: otherCert,
+	}, {
+		name:       "host not covered by an IngressTLS",
+		host:       validHost,
+		tlsHosts:   []string{invalidHost},
+		secretCert: otherCert,
+		wantReady:  true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ing := ingTemplate.DeepCopy()
+			ing.Spec.Rules[0].Hosts = []string{test.host}
+			if test.tlsHosts != nil {
+				ing.Spec.TLS = []v1alpha1.IngressTLS{{
+					Hosts:           test.tlsHosts,
+					SecretName:      secretName,
+					SecretNamespace: ing.Namespace,
+				}}
+			}
+			h, err := ingress.InsertProbe(ing.DeepCopy())
+			if err != nil {
+				t.Fatal("Failed to insert probe:", err)
+			}
+			hash.Store(h)
+
+			var (
+				certErrs    atomic.Int32
+				lastCertErr atomic.Pointer[CertificateError]
+			)
+			opts := []ProberOption{
+				WithInitialDelay(0),
+				WithBaseRetryDelay(10 * time.Millisecond),
+				WithCertificateErrorCallback(func(got *v1alpha1.Ingress, err *CertificateError) {
+					if got != ing {
+						t.Errorf("Certificate error callback invoked for %s, want %s", got.Name, ing.Name)
+					}
+					certErrs.Add(1)
+					lastCertErr.Store(err)
+				}),
+			}
+			if test.rootCAs != nil {
+				opts = append(opts, WithCertificateVerification(test.rootCAs))
+			}
+			if test.secretCert != nil {
+				indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+				indexer.Add(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: ing.Namespace, Name: secretName},
+					Data: map[string][]byte{
+						corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: test.secretCert.Raw}),
+					},
+				})
+				opts = append(opts, WithSecretCertificateVerification(corev1listers.NewSecretLister(indexer)))
+			}
+
+			ready := make(chan *v1alpha1.Ingress)
+			prober := NewProber(
+				zaptest.NewLogger(t).Sugar(),
+				fakeProbeTargetLister{{
+					PodIPs:  sets.New(tsURL.Hostname()),
+					PodPort: tsURL.Port(),
+					Port:    tsURL.Port(),
+					URLs:    []*url.URL{tsURL},
+				}},
+				func(ing *v1alpha1.Ingress) {
+					ready <- ing
+				},
+				opts...)
+
+			done := make(chan struct{})
+			cancelled := prober.Start(done)
+			defer func() {
+				close(done)
+				<-cancelled
+			}()
+
+			ctx := context.Background()
+			if _, err := prober.IsReady(ctx, ing); err != nil {
+				t.Fatal("IsReady failed:", err)
+			}
+
+			if test.wantReady {
+				select {
+				case <-ready:
+				case <-time.After(5 * time.Second):
+					t.Fatal("Timed out waiting for probing to succeed.")
+				}
+				if got := certErrs.Load(); got != 0 {
+					t.Errorf("Certificate error callback invoked %d times, want 0", got)
+				}
+				return
+			}
+
+			// The probe fails with a CertificateError, and is retried
+			var lastErr error
+			if err := waitFor(func() bool {
+				status, err := prober.Status(ctx, ing)
+				if err != nil || status == nil || len(status.Pods) != 1 {
+					return false
+				}
+				lastErr = status.Pods[0].URLs[0].LastError
+				return lastErr != nil && status.Pods[0].URLs[0].Attempts >= 3
+			}); err != nil {
+				t.Fatal("The probe didn't fail:", err)
+			}
+			var certErr *CertificateError
+			if !errors.As(lastErr, &certErr) || certErr.Host != test.host {
+				t.Errorf("Probe error = %v, want a CertificateError for %s", lastErr, test.host)
+			}
+			// The caller is told about it once for the host, rather than as a failure to retry.
+			if got := certErrs.Load(); got != 1 {
+				t.Errorf("Certificate error callback invoked %d times, want 1", got)
+			}
+			if got := lastCertErr.Load(); got == nil || got.Host != test.host {
+				t.Errorf("Certificate error callback error = %v, want a CertificateError for %s", got, test.host)
+			}
+			select {
+			case <-ready:
+				t.Fatal("Prober shouldn't be ready")
+			default:
+			}
+		})
+	}
+}
+
+// selfSignedCertificate returns a self-signed certificate valid for the given host.
+func selfSignedCertificate(t *testing.T, host string) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal("Failed to generate key:", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: host},
+		DNSNames:              []string{host},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal("Failed to create certificate:", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal("Failed to parse certificate:", err)
+	}
+	return cert
+}
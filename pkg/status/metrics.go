@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"knative.dev/pkg/observability/attributekey"
+)
+
+const scopeName = "knative.dev/networking/pkg/status"
+
+var (
+	statusCodeClassKey = attributekey.String("kn.ingress.prober.status_code_class")
+	resultKey          = attributekey.String("kn.ingress.prober.result")
+
+	readyDurationBounds = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600}
+)
+
+// proberMetrics are the instruments of a Prober.
+type proberMetrics struct {
+	readyDuration metric.Float64Histogram
+	attempts      metric.Int64Counter
+	inFlight      metric.Int64UpDownCounter
+
+	// registration is the registration of the callback observing the
+	// queue depth, and the number of tracked Ingresses and Pods.
+	registration metric.Registration
+}
+
+// newProberMetrics creates the instruments of the given Prober from the given provider.
+func newProberMetrics(m *Prober, provider metric.MeterProvider) (*proberMetrics, error) {
+	meter := provider.Meter(scopeName)
+	pm := &proberMetrics{}
+
+	var err error
+	pm.readyDuration, err = meter.Float64Histogram(
+		"kn.ingress.prober.ready.duration",
+		metric.WithDescription("Time from the first readiness check of a version of an Ingress until it is ready"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(readyDurationBounds...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pm.attempts, err = meter.Int64Counter(
+		"kn.ingress.prober.attempts",
+		metric.WithDescription("Number of probe attempts, partitioned by status code class and result"),
+		metric.WithUnit("{probe}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pm.inFlight, err = meter.Int64UpDownCounter(
+		"kn.ingress.prober.in_flight",
+		metric.WithDescription("Number of probe requests in flight"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	queueDepth, err := meter.Int64ObservableGauge(
+		"kn.ingress.prober.queue.depth",
+		metric.WithDescription("Number of items in the probing queue"),
+		metric.WithUnit("{item}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ingresses, err := meter.Int64ObservableGauge(
+		"kn.ingress.prober.ingresses",
+		metric.WithDescription("Number of Ingresses tracked by the prober"),
+		metric.WithUnit("{ingress}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := meter.Int64ObservableGauge(
+		"kn.ingress.prober.pods",
+		metric.WithDescription("Number of Pod ports tracked by the prober"),
+		metric.WithUnit("{pod}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pm.registration, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(queueDepth, int64(m.workQueue.Len()))
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		o.ObserveInt64(ingresses, int64(len(m.ingressStates)))
+		o.ObserveInt64(pods, int64(len(m.podContexts)))
+		return nil
+	}, queueDepth, ingresses, pods)
+	if err != nil {
+		return nil, err
+	}
+
+	return pm, nil
+}
+
+// recordAttempt records a probe attempt with the given response status code, zero if
+// there was no response, and whether the probe succeeded.
+func (pm *proberMetrics) recordAttempt(ctx context.Context, statusCode int, success bool) {
+	class := "error"
+	if statusCode != 0 {
+		class = strconv.Itoa(statusCode/100) + "xx"
+	}
+	result := "failure"
+	if success {
+		result = "success"
+	}
+	pm.attempts.Add(ctx, 1, metric.WithAttributeSet(attribute.NewSet(
+		statusCodeClassKey.With(class),
+		resultKey.With(result),
+	)))
+}
+
+// shutdown stops observing the queue depth, and the number of tracked Ingresses and Pods.
+func (pm *proberMetrics) shutdown() error {
+	if err := pm.registration.Unregister(); err != nil {
+		return fmt.Errorf("failed to unregister the prober metrics callback: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/zap/zaptest"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/pkg/http/header"
+	"knative.dev/networking/pkg/http/probe"
+	"knative.dev/networking/pkg/ingress"
+)
+
+func TestProberMetrics(t *testing.T) {
+	ing := ingTemplate.DeepCopy()
+	hash, err := ingress.InsertProbe(ing.DeepCopy())
+	if err != nil {
+		t.Fatal("Failed to insert probe:", err)
+	}
+
+	// The first probe fails, the following ones succeed
+	var requests atomic.Int32
+	probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		r.Header.Set(header.HashKey, hash)
+		probeHandler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer provider.Shutdown(context.Background())
+
+	ready := make(chan *v1alpha1.Ingress)
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithInitialDelay(0),
+		WithBaseRetryDelay(10*time.Millisecond),
+		WithMeterProvider(provider))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	if _, err := prober.IsReady(context.Background(), ing); err != nil {
+		t.Fatal("IsReady failed:", err)
+	}
+	select {
+	case <-ready:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for probing to succeed.")
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal("Failed to collect the metrics:", err)
+	}
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		if sm.Scope.Name != scopeName {
+			continue
+		}
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	attempts := make(map[string]int64)
+	for _, dp := range metrics["kn.ingress.prober.attempts"].(metricdata.Sum[int64]).DataPoints {
+		class, _ := dp.Attributes.Value(attribute.Key(statusCodeClassKey))
+		result, _ := dp.Attributes.Value(attribute.Key(resultKey))
+		attempts[class.AsString()+" "+result.AsString()] = dp.Value
+	}
+	if diff := cmp.Diff(map[string]int64{"5xx failure": 1, "2xx success": 1}, attempts); diff != "" {
+		t.Error("Unexpected attempts (-want, +got):", diff)
+	}
+
+	readyDuration := metrics["kn.ingress.prober.ready.duration"].(metricdata.Histogram[float64]).DataPoints
+	if len(readyDuration) != 1 || readyDuration[0].Count != 1 || readyDuration[0].Sum <= 0 {
+		t.Errorf("Unexpected ready duration data points: %+v", readyDuration)
+	}
+
+	for name, want := range map[string]int64{
+		"kn.ingress.prober.in_flight":   0,
+		"kn.ingress.prober.queue.depth": 0,
+		"kn.ingress.prober.ingresses":   1,
+		"kn.ingress.prober.pods":        1,
+	} {
+		var got []int64
+		switch data := metrics[name].(type) {
+		case metricdata.Sum[int64]:
+			for _, dp := range data.DataPoints {
+				got = append(got, dp.Value)
+			}
+		case metricdata.Gauge[int64]:
+			for _, dp := range data.DataPoints {
+				got = append(got, dp.Value)
+			}
+		}
+		if diff := cmp.Diff([]int64{want}, got); diff != "" {
+			t.Errorf("Unexpected %s data points (-want, +got): %s", name, diff)
+		}
+	}
+}
+
+func TestProberMetricsCancelled(t *testing.T) {
+	ing := ingTemplate.DeepCopy()
+	// Handler mimicking an Ingress never ready
+	requests := make(chan struct{}, 100)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		select {
+		case requests <- struct{}{}:
+		default:
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer provider.Shutdown(context.Background())
+
+	ready := make(chan *v1alpha1.Ingress, 1)
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithInitialDelay(0),
+		WithMeterProvider(provider))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	if _, err := prober.IsReady(context.Background(), ing); err != nil {
+		t.Fatal("IsReady failed:", err)
+	}
+	select {
+	case <-requests:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the first probe request.")
+	}
+
+	prober.CancelIngressProbing(ing)
+	select {
+	case <-ready:
+		t.Fatal("Ingress reported ready after its probing was cancelled")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal("Failed to collect the metrics:", err)
+	}
+	for _, sm := range rm.ScopeMetrics {
+		if sm.Scope.Name != scopeName {
+			continue
+		}
+		for _, m := range sm.Metrics {
+			if m.Name != "kn.ingress.prober.ready.duration" {
+				continue
+			}
+			// A cancelled Ingress never became ready, so no duration is recorded.
+			if dps := m.Data.(metricdata.Histogram[float64]).DataPoints; len(dps) != 0 {
+				t.Errorf("Unexpected ready duration data points: %+v", dps)
+			}
+		}
+	}
+}
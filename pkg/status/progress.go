@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"cmp"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/pkg/ingress"
+)
+
+// ProbeState is the state of the probe of a URL on a Pod.
+type ProbeState string
+
+const (
+	// ProbeStatePending is the state of the probes which haven't succeeded yet.
+	ProbeStatePending ProbeState = "Pending"
+	// ProbeStateSucceeded is the state of the probes which succeeded.
+	ProbeStateSucceeded ProbeState = "Succeeded"
+	// ProbeStateCancelled is the state of the probes dropped before succeeding,
+	// e.g. because the probing of their Pod was cancelled.
+	ProbeStateCancelled ProbeState = "Cancelled"
+)
+
+// ProbingStatus is the probing progress of a version of an Ingress.
+type ProbingStatus struct {
+	// Hash identifies the version of the Ingress being probed.
+	Hash string
+	// Ready is whether the probing of the Ingress is over.
+	Ready bool
+	// Pods is the probing progress of each Pod port, sorted by IP and port.
+	Pods []PodProbingStatus
+}
+
+// PodProbingStatus is the probing progress of a Pod port for an Ingress.
+type PodProbingStatus struct {
+	// IP and Port identify the probed Pod port.
+	IP   string
+	Port string
+	// Ready is whether none of the probes of the Pod port is pending anymore.
+	Ready bool
+	// URLs is the state of the probe of each URL on the Pod port, sorted by URL.
+	URLs []URLProbingStatus
+}
+
+// URLProbingStatus is the state of the probe of a URL on a Pod port.
+type URLProbingStatus struct {
+	// URL is the probed URL.
+	URL string
+	// State is the state of the probe.
+	State ProbeState
+	// Attempts is the number of probe requests sent so far.
+	Attempts int
+	// LastStatusCode is the status code of the response to the last attempt,
+	// zero if there wasn't any.
+	LastStatusCode int
+	// LastError is the error of the last attempt, nil if it succeeded or if
+	// there wasn't any.
+	LastError error
+}
+
+// PendingPods returns the number of Pod ports whose probing isn't over yet.
+func (s *ProbingStatus) PendingPods() int {
+	pending := 0
+	for _, pod := range s.Pods {
+		if !pod.Ready {
+			pending++
+		}
+	}
+	return pending
+}
+
+// Reporter provides a way to get the detailed probing progress of an Ingress.
+type Reporter interface {
+	// Status returns the probing progress of the provided Ingress, or nil if it
+	// isn't being probed.
+	Status(ctx context.Context, ing *v1alpha1.Ingress) (*ProbingStatus, error)
+}
+
+var _ Reporter = (*Prober)(nil)
+
+// Status returns the probing progress of the provided Ingress. It returns nil if
+// the Ingress is not being probed, or if the probing state refers to a different
+// version of the Ingress.
+func (m *Prober) Status(_ context.Context, ing *v1alpha1.Ingress) (*ProbingStatus, error) {
+	bytes, err := ingress.ComputeHash(ing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute the hash of the Ingress: %w", err)
+	}
+	hash := hex.EncodeToString(bytes[:])
+
+	state := func() *ingressState {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		state, ok := m.ingressStates[types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}]
+		if !ok || state.hash != hash {
+			return nil
+		}
+		return state
+	}()
+	if state == nil {
+		return nil, nil
+	}
+
+	pods := make(map[podKey]*PodProbingStatus, len(state.pods))
+	for _, wi := range state.workItems {
+		key := podKey{ip: wi.podIP, port: wi.podPort}
+		pod, ok := pods[key]
+		if !ok {
+			pod = &PodProbingStatus{IP: key.ip, Port: key.port, Ready: true}
+			pods[key] = pod
+		}
+
+		url := URLProbingStatus{
+			URL:      wi.url.String(),
+			State:    ProbeStatePending,
+			Attempts: int(wi.attempts.Load()),
+		}
+		switch {
+		case wi.succeeded.Load():
+			url.State = ProbeStateSucceeded
+		case wi.done.Load():
+			url.State = ProbeStateCancelled
+		default:
+			pod.Ready = false
+		}
+		if result := wi.lastResult.Load(); result != nil {
+			url.LastStatusCode = result.statusCode
+			url.LastError = result.err
+		}
+		pod.URLs = append(pod.URLs, url)
+	}
+
+	status := &ProbingStatus{
+		Hash:  hash,
+		Ready: state.pendingCount.Load() == 0,
+		Pods:  make([]PodProbingStatus, 0, len(pods)),
+	}
+	for _, pod := range pods {
+		slices.SortFunc(pod.URLs, func(a, b URLProbingStatus) int {
+			return cmp.Compare(a.URL, b.URL)
+		})
+		status.Pods = append(status.Pods, *pod)
+	}
+	slices.SortFunc(status.Pods, func(a, b PodProbingStatus) int {
+		return cmp.Or(cmp.Compare(a.IP, b.IP), cmp.Compare(a.Port, b.Port))
+	})
+	return status, nil
+}
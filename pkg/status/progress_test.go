@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/pkg/http/header"
+	"knative.dev/networking/pkg/http/probe"
+	"knative.dev/networking/pkg/ingress"
+)
+
+func TestProbingStatus(t *testing.T) {
+	const (
+		hostA = "foo.bar.com"
+		hostB = "ksvc.test.dev"
+	)
+
+	ing := ingTemplate.DeepCopy()
+	ing.Spec.Rules[0].Hosts = []string{hostA, hostB}
+	hash, err := ingress.InsertProbe(ing.DeepCopy())
+	if err != nil {
+		t.Fatal("Failed to insert probe:", err)
+	}
+
+	// Probes to hostB never succeed
+	probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == hostB {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.Header.Set(header.HashKey, hash)
+		probeHandler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(*v1alpha1.Ingress) {},
+		WithInitialDelay(0))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	ctx := context.Background()
+	if status, err := prober.Status(ctx, ing); err != nil || status != nil {
+		t.Fatalf("Status() = %v, %v before IsReady, want nil, nil", status, err)
+	}
+	if _, err := prober.IsReady(ctx, ing); err != nil {
+		t.Fatal("IsReady failed:", err)
+	}
+
+	// Wait for hostA to be probed successfully, and hostB to be retried
+	var status *ProbingStatus
+	if err := waitFor(func() bool {
+		status, err = prober.Status(ctx, ing)
+		if err != nil || status == nil || len(status.Pods) != 1 {
+			return false
+		}
+		urls := status.Pods[0].URLs
+		return urls[0].State == ProbeStateSucceeded && urls[1].Attempts > 1
+	}); err != nil {
+		t.Fatalf("Unexpected probing status %+v: %v", status, err)
+	}
+
+	if status.Hash != hash || status.Ready {
+		t.Errorf("Hash, Ready = %q, %t, want: %q, false", status.Hash, status.Ready, hash)
+	}
+	if got, want := status.PendingPods(), 1; got != want {
+		t.Errorf("PendingPods() = %d, want: %d", got, want)
+	}
+	pod := status.Pods[0]
+	if pod.IP != tsURL.Hostname() || pod.Port != tsURL.Port() || pod.Ready {
+		t.Errorf("Pod = %s:%s, ready: %t, want: %s, not ready", pod.IP, pod.Port, pod.Ready, tsURL.Host)
+	}
+	if got := pod.URLs[0]; got.URL != "http://"+hostA || got.Attempts != 1 ||
+		got.LastStatusCode != http.StatusOK || got.LastError != nil {
+		t.Errorf("Probe of %s = %+v, want 1 successful attempt", hostA, got)
+	}
+	const wantErr = "unexpected status code: want 200, got 404"
+	if got := pod.URLs[1]; got.URL != "http://"+hostB || got.State != ProbeStatePending ||
+		got.LastStatusCode != http.StatusNotFound || got.LastError == nil || got.LastError.Error() != wantErr {
+		t.Errorf("Probe of %s = %+v, want pending with status 404 and error %q", hostB, got, wantErr)
+	}
+
+	// The probes dropped with their Pod are reported as cancelled
+	prober.CancelPodProbing(&v1.Pod{Status: v1.PodStatus{PodIP: tsURL.Hostname()}})
+	if err := waitFor(func() bool {
+		status, err = prober.Status(ctx, ing)
+		return err == nil && status != nil && status.Ready
+	}); err != nil {
+		t.Fatalf("Unexpected probing status %+v: %v", status, err)
+	}
+	if got := status.Pods[0].URLs[1].State; got != ProbeStateCancelled {
+		t.Errorf("State of the probe of %s = %s, want: %s", hostB, got, ProbeStateCancelled)
+	}
+
+	// Another version of the Ingress isn't being probed
+	ing.Spec.Rules[0].Hosts = []string{hostA}
+	if status, err := prober.Status(ctx, ing); err != nil || status != nil {
+		t.Errorf("Status() = %v, %v for another version, want nil, nil", status, err)
+	}
+}
@@ -19,7 +19,9 @@ package status
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -27,17 +29,24 @@ import (
 	"os"
 	"path"
 	"reflect"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
 	"knative.dev/networking/pkg/apis/networking/v1alpha1"
@@ -57,6 +66,49 @@ const (
 	// initialDelay defines the delay before enqueuing a probing request the first time.
 	// It gives times for the change to propagate and prevents unnecessary retries.
 	initialDelay = 200 * time.Millisecond
+	// probeBaseRetryDelay defines the initial delay between retries in the backoff of probing
+	probeBaseRetryDelay = 50 * time.Millisecond
+	// probeQPS and probeBurst define the global rate limit of probing
+	probeQPS   = 50
+	probeBurst = 100
+	// maxIdleConnsPerPod bounds the connections kept open to each probed Pod port,
+	// maxIdleConnsPerHost the ones kept open for each probed host of a Pod port.
+	maxIdleConnsPerPod  = 10
+	maxIdleConnsPerHost = 2
+	// idleConnTimeout is how long an unused connection to a Pod port is kept open.
+	idleConnTimeout = 30 * time.Second
+)
+
+// Reasons of the events emitted on the probed Ingresses.
+const (
+	// EventReasonProbeConnectionFailed is the reason of the Warning events emitted when
+	// probes repeatedly fail without a response, e.g. because the Pod can't be dialed.
+	EventReasonProbeConnectionFailed = "ProbeConnectionFailed"
+	// EventReasonProbeCertificateMismatch is the reason of the Warning events emitted when
+	// probes repeatedly get a certificate not matching the probed host.
+	EventReasonProbeCertificateMismatch = "ProbeCertificateMismatch"
+	// EventReasonProbeHashMismatch is the reason of the Warning events emitted when
+	// probes repeatedly reach a Pod serving another version of the Ingress.
+	EventReasonProbeHashMismatch = "ProbeHashMismatch"
+	// EventReasonProbeUnexpectedStatus is the reason of the Warning events emitted when
+	// probes repeatedly get an unexpected response status, e.g. 404 or 503.
+	EventReasonProbeUnexpectedStatus = "ProbeUnexpectedStatus"
+	// EventReasonProbingSucceeded is the reason of the Normal event emitted once all
+	// the Pods serve the current version of the Ingress.
+	EventReasonProbingSucceeded = "ProbingSucceeded"
+)
+
+const (
+	// probeFailureEventThreshold is the number of failed attempts of a probe from which
+	// Warning events are emitted.
+	probeFailureEventThreshold = 3
+	// probeFailureEventInterval is the minimum interval between the Warning events
+	// emitted for a version of an Ingress.
+	probeFailureEventInterval = time.Minute
+
+	// readyStateTTLFactor is the factor applied to the state TTL for the states of the
+	// ready Ingresses, which are cheaper to keep than to probe again.
+	readyStateTTLFactor = 10
 )
 
 // probeMaxRetryDelay defines the maximum delay between retries in the backoff of probing
@@ -70,7 +122,11 @@ func init() {
 	}
 }
 
-var dialContext = (&net.Dialer{Timeout: probeTimeout}).DialContext
+// defaultPorts are the ports implied by the URL schemes.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
 
 // ingressState represents the probing state of an Ingress
 type ingressState struct {
@@ -80,40 +136,122 @@ type ingressState struct {
 	// pendingCount is the number of pods that haven't been successfully probed yet
 	pendingCount atomic.Int64
 	lastAccessed time.Time
+	// created is when the probing of this version of the Ingress started
+	created time.Time
+	// lastWarningEvent is when the last Warning event was emitted, in Unix nanoseconds
+	lastWarningEvent atomic.Int64
+
+	// hosts is the probing state of each host of the Ingress,
+	// it is not modified after the ingressState has been created.
+	hosts map[string]*hostState
+
+	// pods are the Pod IP and port pairs probed for the Ingress,
+	// it is not modified after the ingressState has been created.
+	pods sets.Set[podKey]
+
+	// workItems are the probes of the Ingress,
+	// it is not modified after the ingressState has been created.
+	workItems []*workItem
 
 	cancel func()
 }
 
-// podState represents the probing state of a Pod (for a specific Ingress)
+// hostState represents the probing state of a host (for a specific Ingress)
+type hostState struct {
+	// pendingCount is the number of probes for the host that haven't succeeded yet
+	pendingCount atomic.Int64
+	// succeeded is set once a probe for the host succeeded, the host isn't ready
+	// if all its probes were cancelled
+	succeeded atomic.Bool
+	// certificateErrorReported is set once certificateErrorCallback was invoked for the host
+	certificateErrorReported atomic.Bool
+}
+
+// podKey identifies a port of a Pod to probe
+type podKey struct {
+	ip   string
+	port string
+}
+
+// podState represents the probing state of a Pod port (for a specific Ingress)
 type podState struct {
-	// pendingCount is the number of probes for the Pod
+	// pendingCount is the number of probes for the Pod port
 	pendingCount atomic.Int64
 
+	// workItems are the probes for the Pod port
+	workItems []*workItem
+
 	cancel func()
 }
 
+// podBatch groups the pending probes of a Pod port across all the Ingresses, so that
+// they are queued, rate limited and retried as a single work item.
+type podBatch struct {
+	key podKey
+
+	// workItems are the pending probes of the Pod port, guarded by Prober.mu
+	workItems []*workItem
+}
+
 // cancelContext is a pair of a Context and its cancel function
 type cancelContext struct {
-	context context.Context
-	cancel  func()
+	context      context.Context
+	cancel       func()
+	lastAccessed time.Time
 }
 
 type workItem struct {
 	ingressState *ingressState
 	podState     *podState
+	hostState    *hostState
 	context      context.Context
 	url          *url.URL
+	listenerPort string
 	podIP        string
 	podPort      string
 	logger       *zap.SugaredLogger
+
+	// done is set once the probe succeeded or was cancelled,
+	// succeeded only once it succeeded
+	done      atomic.Bool
+	succeeded atomic.Bool
+
+	// attempts is the number of attempts of the probe, and lastResult the outcome
+	// of the last one, if any
+	attempts   atomic.Int64
+	lastResult atomic.Pointer[probeResult]
+}
+
+// probeResult is the outcome of a probe attempt.
+type probeResult struct {
+	// statusCode is the status code of the response, zero if there was none
+	statusCode int
+	err        error
+}
+
+// recordAttempt records the outcome of an attempt of the probe.
+func (wi *workItem) recordAttempt(statusCode int, err error) {
+	wi.attempts.Add(1)
+	wi.lastResult.Store(&probeResult{statusCode: statusCode, err: err})
 }
 
 // ProbeTarget contains the URLs to probes for a set of Pod IPs serving out of the same port.
 type ProbeTarget struct {
-	PodIPs  sets.Set[string]
+	// PodIPs are the IPs of the Pods to probe.
+	PodIPs sets.Set[string]
+	// PodPort is the port of the Pods the probes are sent to.
 	PodPort string
-	Port    string
-	URLs    []*url.URL
+	// Port is the port of the gateway Service the Pods are exposed through. It is
+	// informational, and doesn't affect the URLs probed.
+	Port string
+	// ListenerPort is the port of the listener as seen by clients, e.g. the IngressRule
+	// or IngressTLS port, as returned by ingress.ProbePort. Unless it's empty or the
+	// default port of the URL scheme, it is set in the URLs that don't carry a port,
+	// so that the Host header matches the listener.
+	// +optional
+	ListenerPort string
+	// URLs are the URLs to probe.
+	URLs []*url.URL
 }
 
 // ProbeTargetLister lists all the targets that requires probing.
@@ -122,6 +260,191 @@ type ProbeTargetLister interface {
 	ListProbeTargets(ctx context.Context, ingress *v1alpha1.Ingress) ([]ProbeTarget, error)
 }
 
+// TransportFactory creates the RoundTripper used to send probes to the given Pod IP
+// and port. The probe URLs carry the probed host rather than the Pod IP, so the
+// RoundTripper is responsible for dialing the Pod. The RoundTripper is reused for
+// all the probes of the Pod port, and its idle connections are closed, if it
+// implements CloseIdleConnections, once the probing of the Pod port is cancelled.
+type TransportFactory func(podIP, podPort string) http.RoundTripper
+
+// ProberOption configures optional behavior of a Prober.
+type ProberOption func(*Prober)
+
+// WithProbeConcurrency sets how many probing calls can be issued simultaneously.
+// Defaults to 15.
+func WithProbeConcurrency(concurrency int) ProberOption {
+	return func(m *Prober) {
+		m.probeConcurrency = concurrency
+	}
+}
+
+// WithProbeTimeout sets the maximum amount of time a probing call may take.
+// Defaults to 1s.
+func WithProbeTimeout(timeout time.Duration) ProberOption {
+	return func(m *Prober) {
+		m.probeTimeout = timeout
+	}
+}
+
+// WithInitialDelay sets the delay before the first probing call for a Pod, giving
+// time for the change to propagate. Defaults to 200ms.
+func WithInitialDelay(delay time.Duration) ProberOption {
+	return func(m *Prober) {
+		m.initialDelay = delay
+	}
+}
+
+// WithBaseRetryDelay sets the initial delay between retries in the exponential
+// backoff of a failing probe. Defaults to 50ms.
+func WithBaseRetryDelay(delay time.Duration) ProberOption {
+	return func(m *Prober) {
+		m.baseRetryDelay = delay
+	}
+}
+
+// WithMaxRetryDelay sets the maximum delay between retries in the exponential
+// backoff of a failing probe. Defaults to 30s, or to the number of seconds set
+// by the PROBE_MAX_RETRY_DELAY_SECONDS environment variable.
+func WithMaxRetryDelay(delay time.Duration) ProberOption {
+	return func(m *Prober) {
+		m.maxRetryDelay = delay
+	}
+}
+
+// WithQPS sets the global rate limit of the probing calls, as a token bucket of
+// the given size refilled at the given rate. Defaults to 50 QPS with a burst of 100.
+func WithQPS(qps float64, burst int) ProberOption {
+	return func(m *Prober) {
+		m.qps = qps
+		m.burst = burst
+	}
+}
+
+// WithRateLimiter sets the rate limiter of the probing queue, replacing the
+// default combination of per probe exponential backoff and global rate limit.
+// The options configuring those are ignored when it is set.
+func WithRateLimiter(rateLimiter workqueue.TypedRateLimiter[any]) ProberOption {
+	return func(m *Prober) {
+		m.rateLimiter = rateLimiter
+	}
+}
+
+// WithTransportFactory sets the factory of the RoundTripper used to send probes.
+// By default, plain HTTP probes are sent over a bounded number of kept-alive
+// connections, and TLS probes over new connections, without verifying the
+// certificates of the Pods in the handshake. The RoundTripper
+// must populate http.Response.TLS for certificate verification to succeed.
+func WithTransportFactory(factory TransportFactory) ProberOption {
+	return func(m *Prober) {
+		m.transportFactory = factory
+	}
+}
+
+// WithHostReadyCallback sets a callback invoked once every probe of a given host
+// of an Ingress succeeded, allowing readiness to be reported per host before the
+// whole Ingress is ready. The probes dropped with their Pod don't count, a host
+// whose probes were all cancelled is never reported ready.
+func WithHostReadyCallback(callback func(ing *v1alpha1.Ingress, host string)) ProberOption {
+	return func(m *Prober) {
+		m.hostReadyCallback = callback
+	}
+}
+
+// WithStateTTL evicts the probing state of the Ingresses not ready yet for which IsReady
+// hasn't been called within the given TTL, cancelling their probes. This covers the
+// Ingresses whose deletion was missed, or whose reconciliation moved to another replica.
+// The Pods not probed for any remaining Ingress are evicted alike. The states of the
+// ready Ingresses are evicted after readyStateTTLFactor times the TTL, as evicting them
+// has the next IsReady call probe the Ingress again, and report it as not ready in the
+// meantime. Stale probing states are kept forever if the TTL is zero, which is the default.
+func WithStateTTL(ttl time.Duration) ProberOption {
+	return func(m *Prober) {
+		m.stateTTL = ttl
+	}
+}
+
+// WithReadinessDeadline invokes notReadyCallback if an Ingress is still not ready the given
+// duration after its current version started being probed. The reason summarizes the
+// probes still pending, with the last status code and error of each, so that it can be
+// reported on the Ingress status. The callback is invoked at most once per version of
+// the Ingress, and the probing goes on afterwards, so readyCallback may still follow.
+func WithReadinessDeadline(deadline time.Duration, notReadyCallback func(ing *v1alpha1.Ingress, reason string)) ProberOption {
+	return func(m *Prober) {
+		m.readinessDeadline = deadline
+		m.notReadyCallback = notReadyCallback
+	}
+}
+
+// WithCertificateErrorCallback sets a callback invoked when a probe of an Ingress fails
+// with a CertificateError, as the certificate served to a host not matching it usually
+// denotes a misconfiguration rather than a change not programmed yet, which the caller
+// may want to report as such on the Ingress status. The callback is invoked at most once
+// per host and version of the Ingress, and the probing goes on afterwards, as the served
+// certificate may still be fixed, so readyCallback may still follow.
+func WithCertificateErrorCallback(callback func(ing *v1alpha1.Ingress, err *CertificateError)) ProberOption {
+	return func(m *Prober) {
+		m.certificateErrorCallback = callback
+	}
+}
+
+// WithBatchedProbing queues a single work item per Pod port, sending one round of
+// probes for all the Ingresses pending on the Pod port rather than a work item per
+// Ingress, Pod and URL. This bounds the queue and the retries to the number of Pod
+// ports when a change touches many Ingresses. In each round, a single probe request
+// is sent per pending Ingress and listener rather than per URL, as a gateway Pod
+// programs all the hosts of an Ingress version at once: the number of requests only
+// grows with the number of Pods and pending Ingresses, not with the number of their
+// hosts, and the responders are unchanged. The HTTPS URLs are still probed one by one
+// when certificates are verified. The probes of all the rounds count towards the probe
+// concurrency, and unless WithRateLimiter is set, each of them takes a token of the
+// global rate limit.
+func WithBatchedProbing() ProberOption {
+	return func(m *Prober) {
+		m.batched = true
+	}
+}
+
+// WithMeterProvider sets the provider of the meter recording the metrics of the Prober.
+// Defaults to the global meter provider.
+func WithMeterProvider(provider metric.MeterProvider) ProberOption {
+	return func(m *Prober) {
+		if provider != nil {
+			m.meterProvider = provider
+		}
+	}
+}
+
+// WithEventRecorder sets the recorder of the events emitted on the probed Ingresses:
+// Warning events when probes fail repeatedly, at most once a minute per Ingress, and
+// a Normal event once all the Pods serve the current version of an Ingress.
+func WithEventRecorder(recorder record.EventRecorder) ProberOption {
+	return func(m *Prober) {
+		m.recorder = recorder
+	}
+}
+
+// WithCertificateVerification verifies the certificates served to the HTTPS probes against
+// the given pool of root CAs, for the probed host, which is sent as SNI. The probes served
+// with a certificate that is not trusted, expired or not valid for the host fail with a
+// CertificateError, see WithCertificateErrorCallback. By default, the certificates are
+// not verified.
+func WithCertificateVerification(rootCAs *x509.CertPool) ProberOption {
+	return func(m *Prober) {
+		m.rootCAs = rootCAs
+	}
+}
+
+// WithSecretCertificateVerification verifies that the certificates served to the HTTPS
+// probes of the hosts of an IngressTLS are the ones of its Secret, comparing the SHA-256
+// fingerprints of the leaf certificates. The probes served with another certificate fail
+// with a CertificateError, see WithCertificateErrorCallback. By default, the certificates
+// are not verified.
+func WithSecretCertificateVerification(secretLister corev1listers.SecretLister) ProberOption {
+	return func(m *Prober) {
+		m.secretLister = secretLister
+	}
+}
+
 // Manager provides a way to check if an Ingress is ready
 type Manager interface {
 	IsReady(ctx context.Context, ing *v1alpha1.Ingress) (bool, error)
@@ -132,10 +455,12 @@ type Manager interface {
 type Prober struct {
 	logger *zap.SugaredLogger
 
-	// mu guards ingressStates and podContexts
+	// mu guards ingressStates, podContexts, transports and batches
 	mu            sync.Mutex
 	ingressStates map[types.NamespacedName]*ingressState
-	podContexts   map[string]cancelContext
+	podContexts   map[podKey]cancelContext
+	transports    map[podKey]http.RoundTripper
+	batches       map[podKey]*podBatch
 
 	workQueue workqueue.TypedRateLimitingInterface[any]
 
@@ -143,31 +468,184 @@ type Prober struct {
 
 	readyCallback func(*v1alpha1.Ingress)
 
+	hostReadyCallback func(*v1alpha1.Ingress, string)
+
+	// notReadyCallback is invoked for the Ingresses not ready after readinessDeadline,
+	// zero disables the deadline.
+	notReadyCallback  func(*v1alpha1.Ingress, string)
+	readinessDeadline time.Duration
+
+	// certificateErrorCallback is invoked for the hosts served a certificate not matching them.
+	certificateErrorCallback func(*v1alpha1.Ingress, *CertificateError)
+
 	probeConcurrency int
+	probeTimeout     time.Duration
+	initialDelay     time.Duration
+	baseRetryDelay   time.Duration
+	maxRetryDelay    time.Duration
+	qps              float64
+	burst            int
+	rateLimiter      workqueue.TypedRateLimiter[any]
+	transportFactory TransportFactory
+
+	// stateTTL is the duration after which the states not accessed are evicted,
+	// zero disables the eviction.
+	stateTTL time.Duration
+
+	// batched is whether the probes are queued per Pod port rather than per work item.
+	batched bool
+	// probeSlots bounds the probes in flight across the batches to the probe concurrency.
+	probeSlots chan struct{}
+	// limiter is the global rate limit the probes of the batches are subject to, if any.
+	limiter *rate.Limiter
+
+	meterProvider metric.MeterProvider
+	metrics       *proberMetrics
+
+	// recorder is the optional recorder of the events emitted on the probed Ingresses.
+	recorder record.EventRecorder
+
+	// rootCAs and secretLister are set to verify the certificates served to the probes.
+	rootCAs      *x509.CertPool
+	secretLister corev1listers.SecretLister
 }
 
-// NewProber creates a new instance of Prober
+// NewProber creates a new instance of Prober.
+// The invalid options are logged and replaced by their defaults, use NewProberWithOptions
+// to get an error instead.
 func NewProber(
 	logger *zap.SugaredLogger,
 	targetLister ProbeTargetLister,
 	readyCallback func(*v1alpha1.Ingress),
+	opts ...ProberOption,
+) *Prober {
+	m := newProber(logger, targetLister, readyCallback, opts)
+	if err := m.validate(); err != nil {
+		logger.Errorw("Invalid Prober options, using their defaults instead", zap.Error(err))
+	}
+	m.init()
+	return m
+}
+
+// NewProberWithOptions creates a new instance of Prober configured with the given options.
+// It returns an error if the options are invalid.
+func NewProberWithOptions(
+	logger *zap.SugaredLogger,
+	targetLister ProbeTargetLister,
+	readyCallback func(*v1alpha1.Ingress),
+	opts ...ProberOption,
+) (*Prober, error) {
+	m := newProber(logger, targetLister, readyCallback, opts)
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid Prober options: %w", err)
+	}
+	m.init()
+	return m, nil
+}
+
+// newProber creates a Prober with the default options overridden by the given ones.
+func newProber(
+	logger *zap.SugaredLogger,
+	targetLister ProbeTargetLister,
+	readyCallback func(*v1alpha1.Ingress),
+	opts []ProberOption,
 ) *Prober {
-	return &Prober{
-		logger:        logger,
-		ingressStates: make(map[types.NamespacedName]*ingressState),
-		podContexts:   make(map[string]cancelContext),
-		workQueue: workqueue.NewNamedRateLimitingQueue(
-			workqueue.NewTypedMaxOfRateLimiter(
-				// Per item exponential backoff
-				workqueue.NewTypedItemExponentialFailureRateLimiter[any](50*time.Millisecond, probeMaxRetryDelay),
-				// Global rate limiter
-				&workqueue.TypedBucketRateLimiter[any]{Limiter: rate.NewLimiter(rate.Limit(50), 100)},
-			),
-			"ProbingQueue"),
+	m := &Prober{
+		logger:           logger,
+		ingressStates:    make(map[types.NamespacedName]*ingressState),
+		podContexts:      make(map[podKey]cancelContext),
+		transports:       make(map[podKey]http.RoundTripper),
+		batches:          make(map[podKey]*podBatch),
 		targetLister:     targetLister,
 		readyCallback:    readyCallback,
 		probeConcurrency: probeConcurrency,
+		probeTimeout:     probeTimeout,
+		initialDelay:     initialDelay,
+		baseRetryDelay:   probeBaseRetryDelay,
+		maxRetryDelay:    probeMaxRetryDelay,
+		qps:              probeQPS,
+		burst:            probeBurst,
+		meterProvider:    otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
+}
+
+// init creates the rate limiters, queue, transport factory and metrics of the Prober.
+func (m *Prober) init() {
+	rateLimiter := m.rateLimiter
+	if rateLimiter == nil {
+		m.limiter = rate.NewLimiter(rate.Limit(m.qps), m.burst)
+		rateLimiter = workqueue.NewTypedMaxOfRateLimiter(
+			// Per item exponential backoff
+			workqueue.NewTypedItemExponentialFailureRateLimiter[any](m.baseRetryDelay, m.maxRetryDelay),
+			// Global rate limiter
+			&workqueue.TypedBucketRateLimiter[any]{Limiter: m.limiter},
+		)
+	}
+	if m.batched {
+		m.probeSlots = make(chan struct{}, m.probeConcurrency)
+	}
+	m.workQueue = workqueue.NewNamedRateLimitingQueue(rateLimiter, "ProbingQueue")
+	if m.transportFactory == nil {
+		m.transportFactory = m.newTransport
+	}
+	metrics, err := newProberMetrics(m, m.meterProvider)
+	if err != nil {
+		m.logger.Errorw("Failed to create the Prober metrics, they are not recorded", zap.Error(err))
+		metrics, _ = newProberMetrics(m, noop.NewMeterProvider())
+	}
+	m.metrics = metrics
+}
+
+// validate checks that the options of the Prober are consistent,
+// and replaces the invalid ones by their defaults.
+func (m *Prober) validate() error {
+	var errs []error
+	if m.probeConcurrency < 1 {
+		errs = append(errs, fmt.Errorf("probe concurrency must be positive, got %d", m.probeConcurrency))
+		m.probeConcurrency = probeConcurrency
+	}
+	if m.probeTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("probe timeout must be positive, got %v", m.probeTimeout))
+		m.probeTimeout = probeTimeout
+	}
+	if m.initialDelay < 0 {
+		errs = append(errs, fmt.Errorf("initial delay must not be negative, got %v", m.initialDelay))
+		m.initialDelay = initialDelay
+	}
+	if m.baseRetryDelay <= 0 {
+		errs = append(errs, fmt.Errorf("base retry delay must be positive, got %v", m.baseRetryDelay))
+		m.baseRetryDelay = probeBaseRetryDelay
+	}
+	if m.maxRetryDelay < m.baseRetryDelay {
+		errs = append(errs, fmt.Errorf("max retry delay must be at least the base retry delay %v, got %v",
+			m.baseRetryDelay, m.maxRetryDelay))
+		m.maxRetryDelay = max(probeMaxRetryDelay, m.baseRetryDelay)
+	}
+	if m.qps <= 0 {
+		errs = append(errs, fmt.Errorf("QPS must be positive, got %v", m.qps))
+		m.qps = probeQPS
+	}
+	if m.burst < 1 {
+		errs = append(errs, fmt.Errorf("burst must be positive, got %d", m.burst))
+		m.burst = probeBurst
+	}
+	if m.stateTTL < 0 {
+		errs = append(errs, fmt.Errorf("state TTL must not be negative, got %v", m.stateTTL))
+		m.stateTTL = 0
+	}
+	if m.readinessDeadline < 0 {
+		errs = append(errs, fmt.Errorf("readiness deadline must not be negative, got %v", m.readinessDeadline))
+		m.readinessDeadline = 0
+	}
+	if m.readinessDeadline > 0 && m.notReadyCallback == nil {
+		errs = append(errs, errors.New("readiness deadline requires a not ready callback"))
+		m.readinessDeadline = 0
+	}
+	return errors.Join(errs...)
 }
 
 // IsReady checks if the provided Ingress is ready, i.e. the Envoy pods serving the Ingress
@@ -208,78 +686,110 @@ func (m *Prober) IsReady(ctx context.Context, ing *v1alpha1.Ingress) (bool, erro
 		hash:         hash,
 		ing:          ing,
 		lastAccessed: time.Now(),
+		created:      time.Now(),
+		hosts:        make(map[string]*hostState),
+		pods:         make(sets.Set[podKey]),
 		cancel:       cancel,
 	}
 
-	// Get the probe targets and group them by IP
+	// Get the probe targets and group them by IP and port
 	targets, err := m.targetLister.ListProbeTargets(ctx, ing)
 	if err != nil {
 		return false, err
 	}
-	workItems := make(map[string][]*workItem)
+	workItems := make(map[podKey][]*workItem)
 	for _, target := range targets {
 		for ip := range target.PodIPs {
+			key := podKey{ip: ip, port: target.PodPort}
 			for _, url := range target.URLs {
-				workItems[ip] = append(workItems[ip], &workItem{
+				hs, ok := ingressState.hosts[url.Hostname()]
+				if !ok {
+					hs = &hostState{}
+					ingressState.hosts[url.Hostname()] = hs
+				}
+				hs.pendingCount.Add(1)
+				ingressState.pods.Insert(key)
+				wi := &workItem{
 					ingressState: ingressState,
+					hostState:    hs,
 					url:          url,
+					listenerPort: target.ListenerPort,
 					podIP:        ip,
 					podPort:      target.PodPort,
 					logger:       logger,
-				})
+				}
+				ingressState.workItems = append(ingressState.workItems, wi)
+				workItems[key] = append(workItems[key], wi)
 			}
 		}
 	}
 
 	ingressState.pendingCount.Store(int64(len(workItems)))
 
-	for ip, ipWorkItems := range workItems {
-		// Get or create the context for that IP
-		ipCtx := func() context.Context {
+	if m.readinessDeadline > 0 && len(workItems) > 0 {
+		timer := time.AfterFunc(m.readinessDeadline, func() {
+			m.onReadinessDeadline(ingCtx, ingressState)
+		})
+		context.AfterFunc(ingCtx, func() { timer.Stop() })
+	}
+
+	for key, podWorkItems := range workItems {
+		// Get or create the context for that IP and port
+		portCtx := func() context.Context {
 			m.mu.Lock()
 			defer m.mu.Unlock()
-			cancelCtx, ok := m.podContexts[ip]
+			cancelCtx, ok := m.podContexts[key]
 			if !ok {
 				ctx, cancel := context.WithCancel(context.Background())
 				cancelCtx = cancelContext{
 					context: ctx,
 					cancel:  cancel,
 				}
-				m.podContexts[ip] = cancelCtx
 			}
+			cancelCtx.lastAccessed = time.Now()
+			m.podContexts[key] = cancelCtx
 			return cancelCtx.context
 		}()
 
 		podCtx, cancel := context.WithCancel(ingCtx)
 		podState := &podState{
-			cancel: cancel,
+			workItems: podWorkItems,
+			cancel:    cancel,
 		}
 
-		podState.pendingCount.Store(int64(len(ipWorkItems)))
+		podState.pendingCount.Store(int64(len(podWorkItems)))
 
-		// Quick and dirty way to join two contexts (i.e. podCtx is cancelled when either ingCtx or ipCtx are cancelled)
-		go func() {
-			select {
-			case <-podCtx.Done():
-				// This is the actual context, there is nothing to do except
-				// break to avoid leaking this goroutine.
-				break
-			case <-ipCtx.Done():
-				// Cancel podCtx
-				cancel()
-			}
-		}()
+		// Join the two contexts, i.e. podCtx is cancelled when either ingCtx or portCtx are cancelled
+		stop := context.AfterFunc(portCtx, cancel)
 
 		// Update the states when probing is cancelled
-		go func() {
-			<-podCtx.Done()
-			m.onProbingCancellation(ingressState, podState)
-		}()
+		context.AfterFunc(podCtx, func() {
+			stop()
+			m.onProbingCancellation(ingCtx, ingressState, podState)
+		})
 
-		for _, wi := range ipWorkItems {
+		for _, wi := range podWorkItems {
 			wi.podState = podState
 			wi.context = podCtx //nolint:fatcontext
-			m.workQueue.AddAfter(wi, initialDelay)
+		}
+
+		// The TLS probes of the new version must not reuse connections opened before it.
+		if slices.ContainsFunc(podWorkItems, func(wi *workItem) bool { return wi.url.Scheme == "https" }) {
+			m.renewTLSConnections(key)
+		}
+
+		if m.batched {
+			batch := m.addToBatch(key, podWorkItems)
+			// Reset the backoff of the batch, so that the new probes don't wait for the
+			// retry delay reached by the probes already pending.
+			m.workQueue.Forget(batch)
+			m.workQueue.AddAfter(batch, m.initialDelay)
+			logger.Infof("Queuing %d probes in the batch of IP: %s:%s (depth: %d)",
+				len(podWorkItems), key.ip, key.port, m.workQueue.Len())
+			continue
+		}
+		for _, wi := range podWorkItems {
+			m.workQueue.AddAfter(wi, m.initialDelay)
 			logger.Infof("Queuing probe for %s, IP: %s:%s (depth: %d)",
 				wi.url, wi.podIP, wi.podPort, m.workQueue.Len())
 		}
@@ -293,6 +803,29 @@ func (m *Prober) IsReady(ctx context.Context, ing *v1alpha1.Ingress) (bool, erro
 	return len(workItems) == 0, nil
 }
 
+// HostsReady returns the readiness of each probed host of the provided Ingress.
+// It returns nil if the Ingress is not being probed, or if the probing state
+// refers to a different version of the Ingress.
+func (m *Prober) HostsReady(ing *v1alpha1.Ingress) map[string]bool {
+	bytes, err := ingress.ComputeHash(ing)
+	if err != nil {
+		return nil
+	}
+	hash := hex.EncodeToString(bytes[:])
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.ingressStates[types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}]
+	if !ok || state.hash != hash {
+		return nil
+	}
+	ready := make(map[string]bool, len(state.hosts))
+	for host, hs := range state.hosts {
+		ready[host] = hs.pendingCount.Load() == 0 && hs.succeeded.Load()
+	}
+	return ready
+}
+
 // Start starts the Manager background operations
 func (m *Prober) Start(done <-chan struct{}) chan struct{} {
 	var wg sync.WaitGroup
@@ -308,6 +841,25 @@ func (m *Prober) Start(done <-chan struct{}) chan struct{} {
 		}()
 	}
 
+	// Evict the stale states periodically
+	if m.stateTTL > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ticker := time.NewTicker(m.stateTTL / 2)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-done:
+					return
+				case now := <-ticker.C:
+					m.sweep(now.Add(-m.stateTTL), now.Add(-readyStateTTLFactor*m.stateTTL))
+				}
+			}
+		}()
+	}
+
 	// Stop processing the queue when cancelled
 	go func() {
 		<-done
@@ -318,11 +870,50 @@ func (m *Prober) Start(done <-chan struct{}) chan struct{} {
 	ch := make(chan struct{})
 	go func() {
 		wg.Wait()
+		m.closeTransports()
+		if err := m.metrics.shutdown(); err != nil {
+			m.logger.Errorw("Failed to shut down the Prober metrics", zap.Error(err))
+		}
 		close(ch)
 	}()
 	return ch
 }
 
+// sweep evicts and cancels the ingressStates last accessed before the given time, or
+// before readyBefore for the ready ones, and the podContexts last accessed before the
+// given time which no remaining ingressState probes.
+func (m *Prober) sweep(before, readyBefore time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	inUse := make(sets.Set[podKey], len(m.podContexts))
+	for key, state := range m.ingressStates {
+		ready := state.pendingCount.Load() == 0
+		evictBefore := before
+		if ready {
+			evictBefore = readyBefore
+		}
+		if state.lastAccessed.Before(evictBefore) {
+			// Cancelling the probing of a ready Ingress doesn't notify readyCallback again.
+			m.logger.Infof("Evicting the probing state of Ingress %s, not accessed since %v", key, state.lastAccessed)
+			state.cancel()
+			delete(m.ingressStates, key)
+			continue
+		}
+		if !ready {
+			inUse.Insert(state.pods.UnsortedList()...)
+		}
+	}
+	for key, ctx := range m.podContexts {
+		if ctx.lastAccessed.Before(before) && !inUse.Has(key) {
+			m.logger.Infof("Evicting the probing context of Pod %s:%s, not accessed since %v", key.ip, key.port, ctx.lastAccessed)
+			ctx.cancel()
+			delete(m.podContexts, key)
+			m.evictTransport(key)
+		}
+	}
+}
+
 // CancelIngressProbing cancels probing of the provided Ingress
 func (m *Prober) CancelIngressProbing(obj interface{}) {
 	acc, err := kmeta.DeletionHandlingAccessor(obj)
@@ -344,21 +935,120 @@ func (m *Prober) CancelIngressProbingByKey(key types.NamespacedName) {
 	}
 }
 
-// CancelPodProbing cancels probing of the provided Pod IP.
-//
-// TODO(#6269): make this cancellation based on Pod x port instead of just Pod.
+// CancelPodProbing cancels probing of all the ports of the provided Pod.
 func (m *Prober) CancelPodProbing(obj interface{}) {
 	if pod, ok := obj.(*corev1.Pod); ok {
-		m.mu.Lock()
-		defer m.mu.Unlock()
+		m.cancelPodProbing(func(key podKey) bool {
+			return key.ip == pod.Status.PodIP
+		})
+	}
+}
+
+// CancelPodPortProbing cancels probing of the given port of the provided Pod,
+// leaving the probing of its other ports untouched.
+func (m *Prober) CancelPodPortProbing(obj interface{}, port string) {
+	if pod, ok := obj.(*corev1.Pod); ok {
+		want := podKey{ip: pod.Status.PodIP, port: port}
+		m.cancelPodProbing(func(key podKey) bool {
+			return key == want
+		})
+	}
+}
+
+// cancelPodProbing cancels probing of the Pod ports matching the given predicate.
+func (m *Prober) cancelPodProbing(matches func(podKey) bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-		if ctx, ok := m.podContexts[pod.Status.PodIP]; ok {
+	for key, ctx := range m.podContexts {
+		if matches(key) {
 			ctx.cancel()
-			delete(m.podContexts, pod.Status.PodIP)
+			delete(m.podContexts, key)
+			m.evictTransport(key)
 		}
 	}
 }
 
+// addToBatch adds the work items to the batch of the given Pod port, creating it if needed,
+// and returns the batch.
+func (m *Prober) addToBatch(key podKey, workItems []*workItem) *podBatch {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	batch, ok := m.batches[key]
+	if !ok {
+		batch = &podBatch{key: key}
+		m.batches[key] = batch
+	}
+	batch.workItems = append(batch.workItems, workItems...)
+	return batch
+}
+
+// transport returns the RoundTripper sending probes to the given Pod port, creating
+// it if needed. The RoundTripper is cached as long as the Pod port is being probed,
+// otherwise it is only meant for a single probe, and cached is false.
+func (m *Prober) transport(key podKey) (rt http.RoundTripper, cached bool) {
+	m.mu.Lock()
+	rt, ok := m.transports[key]
+	m.mu.Unlock()
+	if ok {
+		return rt, true
+	}
+
+	// The factory is user provided, so it is called without holding mu.
+	rt = m.transportFactory(key.ip, key.port)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if cachedRT, ok := m.transports[key]; ok {
+		// Another probe of the Pod port created one in the meantime.
+		closeIdleConnections(rt)
+		return cachedRT, true
+	}
+	if _, ok := m.podContexts[key]; !ok {
+		// The probing was cancelled in the meantime.
+		return rt, false
+	}
+	m.transports[key] = rt
+	return rt, true
+}
+
+// renewTLSConnections renews the Transport of the TLS probes of the given Pod port, if cached.
+func (m *Prober) renewTLSConnections(key podKey) {
+	m.mu.Lock()
+	rt := m.transports[key]
+	m.mu.Unlock()
+	if t, ok := rt.(*probeTransport); ok {
+		t.renewTLS()
+	}
+}
+
+// evictTransport drops the RoundTripper of the given Pod port from the cache and
+// closes its idle connections. It must be called with mu held.
+func (m *Prober) evictTransport(key podKey) {
+	if rt, ok := m.transports[key]; ok {
+		closeIdleConnections(rt)
+		delete(m.transports, key)
+	}
+}
+
+// closeTransports evicts all the cached RoundTrippers.
+func (m *Prober) closeTransports() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key := range m.transports {
+		m.evictTransport(key)
+	}
+}
+
+// closeIdleConnections closes the idle connections of the RoundTripper, if it supports it.
+func closeIdleConnections(rt http.RoundTripper) {
+	if c, ok := rt.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
 // processWorkItem processes a single work item from workQueue.
 // It returns false when there is no more items to process, true otherwise.
 func (m *Prober) processWorkItem() bool {
@@ -369,6 +1059,11 @@ func (m *Prober) processWorkItem() bool {
 
 	defer m.workQueue.Done(obj)
 
+	if batch, ok := obj.(*podBatch); ok {
+		m.processBatch(batch)
+		return true
+	}
+
 	// Crash if the item is not of the expected type
 	item, ok := obj.(*workItem)
 	if !ok {
@@ -378,36 +1073,12 @@ func (m *Prober) processWorkItem() bool {
 	item.logger.Infof("Processing probe for %s, IP: %s:%s (depth: %d)",
 		item.url, item.podIP, item.podPort, m.workQueue.Len())
 
-	transport := http.DefaultTransport.(*http.Transport).Clone()
-	transport.TLSClientConfig = &tls.Config{
-		//nolint:gosec
-		// We only want to know that the Gateway is configured, not that the configuration is valid.
-		// Therefore, we can safely ignore any TLS certificate validation.
-		InsecureSkipVerify: true,
-	}
-	// Disable keep-alives to prevent connection leak since a new transport is created per work item.
-	transport.DisableKeepAlives = true
-	transport.DialContext = func(ctx context.Context, network, addr string) (conn net.Conn, e error) {
-		// Requests with the IP as hostname and the Host header set do no pass client-side validation
-		// because the HTTP client validates that the hostname (not the Host header) matches the server
-		// TLS certificate Common Name or Alternative Names. Therefore, http.Request.URL is set to the
-		// hostname and it is substituted it here with the target IP.
-		return dialContext(ctx, network, net.JoinHostPort(item.podIP, item.podPort))
+	transport, cached := m.transport(podKey{ip: item.podIP, port: item.podPort})
+	if !cached {
+		defer closeIdleConnections(transport)
 	}
 
-	probeURL := deepCopy(item.url)
-	probeURL.Path = path.Join(probeURL.Path, nethttp.HealthCheckPath)
-
-	ctx, cancel := context.WithTimeout(item.context, probeTimeout)
-	defer cancel()
-	ok, err := prober.Do(
-		ctx,
-		transport,
-		probeURL.String(),
-		prober.WithHeader(header.UserAgentKey, header.IngressReadinessUserAgent),
-		prober.WithHeader(header.ProbeKey, header.ProbeValue),
-		prober.WithHeader(header.HashKey, header.HashValueOverride),
-		m.probeVerifier(item))
+	ok, statusCode, err := m.probe(transport, item)
 
 	// In case of cancellation, drop the work item
 	select {
@@ -417,19 +1088,280 @@ func (m *Prober) processWorkItem() bool {
 	default:
 	}
 
+	item.recordAttempt(statusCode, err)
+	m.metrics.recordAttempt(item.context, statusCode, err == nil && ok)
 	if err != nil || !ok {
 		// In case of error, enqueue for retry
+		m.onProbeFailure(item, statusCode, err)
 		m.workQueue.AddRateLimited(obj)
 		item.logger.Errorf("Probing of %s failed, IP: %s:%s, ready: %t, error: %v (depth: %d)",
 			item.url, item.podIP, item.podPort, ok, err, m.workQueue.Len())
 	} else {
 		m.workQueue.Forget(obj)
-		m.onProbingSuccess(item.ingressState, item.podState)
+		m.onProbingSuccess(item)
 	}
 	return true
 }
 
-func (m *Prober) onProbingSuccess(ingressState *ingressState, podState *podState) {
+// probe sends the probe of the work item with the given transport, and returns whether
+// it succeeded, along with the status code of the response, zero if there was none.
+func (m *Prober) probe(transport http.RoundTripper, item *workItem) (bool, int, error) {
+	ctx, cancel := context.WithTimeout(item.context, m.probeTimeout)
+	defer cancel()
+	var statusCode int
+	m.metrics.inFlight.Add(ctx, 1)
+	defer m.metrics.inFlight.Add(ctx, -1)
+	ok, err := prober.Do(
+		ctx,
+		transport,
+		healthCheckURL(item).String(),
+		prober.WithHeader(header.UserAgentKey, header.IngressReadinessUserAgent),
+		prober.WithHeader(header.ProbeKey, header.ProbeValue),
+		prober.WithHeader(header.HashKey, header.HashValueOverride),
+		prober.Verifier(func(r *http.Response, _ []byte) (bool, error) {
+			statusCode = r.StatusCode
+			return true, nil
+		}),
+		m.probeVerifier(item))
+	return ok, statusCode, err
+}
+
+// processBatch sends one round of probes for the pending work items of the batch,
+// and queues the batch for retry if some of them are still pending afterwards.
+func (m *Prober) processBatch(batch *podBatch) {
+	m.mu.Lock()
+	pending := slices.DeleteFunc(slices.Clone(batch.workItems), func(wi *workItem) bool {
+		return wi.done.Load() || wi.context.Err() != nil
+	})
+	m.mu.Unlock()
+	m.logger.Infof("Processing %d probes of the batch of IP: %s:%s (depth: %d)",
+		len(pending), batch.key.ip, batch.key.port, m.workQueue.Len())
+
+	transport, cached := m.transport(batch.key)
+	if !cached {
+		defer closeIdleConnections(transport)
+	}
+
+	var (
+		wg         sync.WaitGroup
+		progressed atomic.Bool
+	)
+	for _, group := range m.probeGroups(pending) {
+		item := group[0]
+		if m.limiter != nil {
+			if err := m.limiter.Wait(item.context); err != nil {
+				// The probing was cancelled in the meantime.
+				continue
+			}
+		}
+		m.probeSlots <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-m.probeSlots }()
+			if m.probeBatched(transport, group) {
+				progressed.Store(true)
+			}
+		})
+	}
+	wg.Wait()
+
+	empty := func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		batch.workItems = slices.DeleteFunc(batch.workItems, func(wi *workItem) bool {
+			return wi.done.Load() || wi.context.Err() != nil
+		})
+		if len(batch.workItems) > 0 {
+			return false
+		}
+		if m.batches[batch.key] == batch {
+			delete(m.batches, batch.key)
+		}
+		return true
+	}()
+	switch {
+	case empty:
+		m.workQueue.Forget(batch)
+	case progressed.Load():
+		// Restart the backoff, so that the probes still pending don't wait for the
+		// retry delay reached before because of other probes of the batch.
+		m.workQueue.Forget(batch)
+		m.workQueue.AddRateLimited(batch)
+	default:
+		m.workQueue.AddRateLimited(batch)
+	}
+}
+
+// probeGroupKey identifies the work items of a batch covered by a single probe.
+type probeGroupKey struct {
+	ingressState *ingressState
+	scheme       string
+	listenerPort string
+	// host is only set when the certificate served to each host must be verified.
+	host string
+}
+
+// probeGroups groups the pending work items of a batch by Ingress version and listener,
+// as a gateway Pod programs all the hosts of an Ingress version at once, so that a probe
+// of one of them tells whether the others are served too. The HTTPS work items aren't
+// grouped when certificates are verified, since each host may be served its own one.
+// The first work item of each group is the one probed, which rotates across the
+// rounds so that a single faulty host can't hold back the others of its group.
+func (m *Prober) probeGroups(pending []*workItem) [][]*workItem {
+	var (
+		groups  [][]*workItem
+		indices = make(map[probeGroupKey]int)
+	)
+	for _, item := range pending {
+		key := probeGroupKey{
+			ingressState: item.ingressState,
+			scheme:       item.url.Scheme,
+			listenerPort: item.listenerPort,
+		}
+		if item.url.Scheme == "https" && m.verifiesCertificates() {
+			key.host = item.url.Host
+		}
+		if idx, ok := indices[key]; ok {
+			groups[idx] = append(groups[idx], item)
+			continue
+		}
+		indices[key] = len(groups)
+		groups = append(groups, []*workItem{item})
+	}
+	for i, group := range groups {
+		offset := int(group[0].attempts.Load() % int64(len(group)))
+		groups[i] = slices.Concat(group[offset:], group[:offset])
+	}
+	return groups
+}
+
+// probeBatched sends a single probe for a group of work items of a batch, records its
+// outcome for all of them, and returns whether it succeeded.
+func (m *Prober) probeBatched(transport http.RoundTripper, group []*workItem) bool {
+	item := group[0]
+	ok, statusCode, err := m.probe(transport, item)
+	if item.context.Err() != nil {
+		// The probing was cancelled, drop the result
+		return false
+	}
+
+	for _, wi := range group {
+		wi.recordAttempt(statusCode, err)
+	}
+	m.metrics.recordAttempt(item.context, statusCode, err == nil && ok)
+	if err != nil || !ok {
+		m.onProbeFailure(item, statusCode, err)
+		item.logger.Errorf("Probing of %s failed for %d URL(s), IP: %s:%s, ready: %t, error: %v",
+			item.url, len(group), item.podIP, item.podPort, ok, err)
+		return false
+	}
+	for _, wi := range group {
+		m.onProbingSuccess(wi)
+	}
+	return true
+}
+
+// healthCheckURL returns the URL the probe of the work item is sent to.
+func healthCheckURL(item *workItem) *url.URL {
+	probeURL := deepCopy(item.url)
+	probeURL.Path = path.Join(probeURL.Path, nethttp.HealthCheckPath)
+	if item.listenerPort != "" && probeURL.Port() == "" && item.listenerPort != defaultPorts[probeURL.Scheme] {
+		probeURL.Host = net.JoinHostPort(probeURL.Hostname(), item.listenerPort)
+	}
+	return probeURL
+}
+
+// newTransport creates the default RoundTripper sending probes to the given Pod IP and port.
+// The connections of the plain HTTP probes are kept alive, as gateways apply route updates to
+// the existing connections. The connections of the TLS probes are kept alive as well, but
+// gateways select the listener, certificate and filter chain serving an SNI host on the TLS
+// handshake, so that a kept-alive connection may keep being served by a stale configuration.
+// To prevent that, the TLS Transport is renewed whenever a new version of an Ingress starts
+// being probed on the Pod port: a TLS probe is never sent over a connection opened before
+// the version of the Ingress it probes.
+func (m *Prober) newTransport(podIP, podPort string) http.RoundTripper {
+	return &probeTransport{
+		plain: m.newPodTransport(podIP, podPort),
+		tls:   m.newPodTransport(podIP, podPort),
+		newTLS: func() *http.Transport {
+			return m.newPodTransport(podIP, podPort)
+		},
+	}
+}
+
+// newPodTransport creates a Transport dialing the given Pod IP and port.
+// Connections are reused across the probes of the Pod port, and closed once its probing is cancelled.
+func (m *Prober) newPodTransport(podIP, podPort string) *http.Transport {
+	dialer := &net.Dialer{Timeout: m.probeTimeout}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		//nolint:gosec
+		// We only want to know that the Gateway is configured, not that the configuration is valid.
+		// Therefore, we can safely ignore any TLS certificate validation during the handshake.
+		// The probed host is sent as SNI, and when certificate verification is enabled, the
+		// served certificates are verified against it once the response is received.
+		InsecureSkipVerify: true,
+	}
+	transport.MaxIdleConns = maxIdleConnsPerPod
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.IdleConnTimeout = idleConnTimeout
+	transport.DialContext = func(ctx context.Context, network, _ string) (conn net.Conn, e error) {
+		// Requests with the IP as hostname and the Host header set do no pass client-side validation
+		// because the HTTP client validates that the hostname (not the Host header) matches the server
+		// TLS certificate Common Name or Alternative Names. Therefore, http.Request.URL is set to the
+		// hostname and it is substituted it here with the target IP.
+		return dialer.DialContext(ctx, network, net.JoinHostPort(podIP, podPort))
+	}
+	return transport
+}
+
+// probeTransport sends the plain HTTP and the TLS probes of a Pod port with distinct Transports.
+type probeTransport struct {
+	plain *http.Transport
+
+	// newTLS creates the Transport of the TLS probes.
+	newTLS func() *http.Transport
+
+	// mu guards tls, which is replaced by renewTLS.
+	mu  sync.RWMutex
+	tls *http.Transport
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *probeTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.URL.Scheme == "https" {
+		return t.tlsTransport().RoundTrip(r)
+	}
+	return t.plain.RoundTrip(r)
+}
+
+// CloseIdleConnections closes the idle connections of both Transports.
+func (t *probeTransport) CloseIdleConnections() {
+	t.plain.CloseIdleConnections()
+	t.tlsTransport().CloseIdleConnections()
+}
+
+func (t *probeTransport) tlsTransport() *http.Transport {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.tls
+}
+
+// renewTLS replaces the Transport of the TLS probes, so that the following TLS probes
+// open new connections. The idle connections of the former Transport are closed, the
+// ones still in use are closed once idle for idleConnTimeout.
+func (t *probeTransport) renewTLS() {
+	t.mu.Lock()
+	old := t.tls
+	t.tls = t.newTLS()
+	t.mu.Unlock()
+	old.CloseIdleConnections()
+}
+
+func (m *Prober) onProbingSuccess(item *workItem) {
+	ingressState, podState := item.ingressState, item.podState
+	item.succeeded.Store(true)
+	m.onHostProbed(item)
+
 	// The last probe call for the Pod succeeded, the Pod is ready
 	if podState.pendingCount.Add(-1) == 0 {
 		// Unlock the goroutine blocked on <-podCtx.Done()
@@ -437,12 +1369,113 @@ func (m *Prober) onProbingSuccess(ingressState *ingressState, podState *podState
 
 		// This is the last pod being successfully probed, the Ingress is ready
 		if ingressState.pendingCount.Add(-1) == 0 {
-			m.readyCallback(ingressState.ing)
+			m.onIngressReady(ingressState)
+		}
+	}
+}
+
+// onIngressReady records how long the probing of the Ingress took, and notifies readyCallback.
+func (m *Prober) onIngressReady(ingressState *ingressState) {
+	m.metrics.readyDuration.Record(context.Background(), time.Since(ingressState.created).Seconds())
+	if m.recorder != nil {
+		m.recorder.Eventf(ingressState.ing, corev1.EventTypeNormal, EventReasonProbingSucceeded,
+			"Probing succeeded on %d Pod port(s)", len(ingressState.pods))
+	}
+	m.readyCallback(ingressState.ing)
+}
+
+// onProbeFailure notifies certificateErrorCallback of the first certificate mismatch of
+// the host of the work item, and emits a Warning event on its Ingress if its probe failed
+// repeatedly, unless one was emitted for the Ingress recently.
+func (m *Prober) onProbeFailure(item *workItem, statusCode int, err error) {
+	var certErr *CertificateError
+	isCertErr := errors.As(err, &certErr)
+	if isCertErr && m.certificateErrorCallback != nil && item.hostState.certificateErrorReported.CompareAndSwap(false, true) {
+		m.certificateErrorCallback(item.ingressState.ing, certErr)
+	}
+
+	attempts := item.attempts.Load()
+	if m.recorder == nil || attempts < probeFailureEventThreshold {
+		return
+	}
+
+	state, now := item.ingressState, time.Now()
+	last := state.lastWarningEvent.Load()
+	if last != 0 && now.Sub(time.Unix(0, last)) < probeFailureEventInterval {
+		return
+	}
+	if !state.lastWarningEvent.CompareAndSwap(last, now.UnixNano()) {
+		// Another worker emitted one in the meantime.
+		return
+	}
+
+	reason := EventReasonProbeUnexpectedStatus
+	switch {
+	case isCertErr:
+		reason = EventReasonProbeCertificateMismatch
+	case statusCode == 0:
+		reason = EventReasonProbeConnectionFailed
+	case statusCode == http.StatusOK:
+		reason = EventReasonProbeHashMismatch
+	}
+	m.recorder.Eventf(state.ing, corev1.EventTypeWarning, reason, "Probing of %s on Pod %s failed %d times: %v",
+		item.url, net.JoinHostPort(item.podIP, item.podPort), attempts, err)
+}
+
+// maxPendingProbesReported bounds the pending probes detailed in the reason passed to
+// notReadyCallback, so that it fits in a condition message.
+const maxPendingProbesReported = 10
+
+// onReadinessDeadline invokes notReadyCallback with a summary of the pending probes
+// of the Ingress, unless its probing is over.
+func (m *Prober) onReadinessDeadline(ctx context.Context, ingressState *ingressState) {
+	if ctx.Err() != nil || ingressState.pendingCount.Load() == 0 {
+		return
+	}
+
+	var pending []string
+	for _, wi := range ingressState.workItems {
+		if wi.done.Load() {
+			continue
+		}
+		outcome := "no response yet"
+		if result := wi.lastResult.Load(); result != nil {
+			switch {
+			case result.statusCode == 0:
+				outcome = fmt.Sprint(result.err)
+			case result.err == nil:
+				outcome = fmt.Sprintf("status %d", result.statusCode)
+			default:
+				outcome = fmt.Sprintf("status %d: %v", result.statusCode, result.err)
+			}
 		}
+		pending = append(pending, fmt.Sprintf("%s on %s (%s)",
+			wi.url, net.JoinHostPort(wi.podIP, wi.podPort), outcome))
+	}
+	if len(pending) == 0 {
+		// The remaining probes were cancelled in the meantime.
+		return
+	}
+	slices.Sort(pending)
+
+	reason := fmt.Sprintf("%d of %d probes not successful after %v: ",
+		len(pending), len(ingressState.workItems), m.readinessDeadline)
+	if len(pending) > maxPendingProbesReported {
+		reason += strings.Join(pending[:maxPendingProbesReported], ", ") +
+			fmt.Sprintf(" and %d more", len(pending)-maxPendingProbesReported)
+	} else {
+		reason += strings.Join(pending, ", ")
 	}
+	m.logger.Warnf("Ingress %s/%s not ready: %s", ingressState.ing.Namespace, ingressState.ing.Name, reason)
+	m.notReadyCallback(ingressState.ing, reason)
 }
 
-func (m *Prober) onProbingCancellation(ingressState *ingressState, podState *podState) {
+func (m *Prober) onProbingCancellation(ingCtx context.Context, ingressState *ingressState, podState *podState) {
+	if ingCtx.Err() != nil {
+		// The probing of the Ingress was cancelled (it was deleted, updated or evicted),
+		// its remaining probes didn't succeed so it must not be reported ready.
+		return
+	}
 	for {
 		pendingCount := podState.pendingCount.Load()
 		if pendingCount <= 0 {
@@ -452,17 +1485,42 @@ func (m *Prober) onProbingCancellation(ingressState *ingressState, podState *pod
 
 		// Attempt to set pendingCount to 0.
 		if podState.pendingCount.CompareAndSwap(pendingCount, 0) {
+			// The remaining probes of the Pod are dropped, their hosts are ready
+			// only if they were successfully probed on another Pod.
+			for _, wi := range podState.workItems {
+				m.onHostProbed(wi)
+			}
 			// This is the last pod being successfully probed, the Ingress is ready
 			if ingressState.pendingCount.Add(-1) == 0 {
-				m.readyCallback(ingressState.ing)
+				m.onIngressReady(ingressState)
 			}
 			return
 		}
 	}
 }
 
+// onHostProbed records that the probe of the work item is over, and notifies
+// hostReadyCallback if it was the last pending probe of its host and a probe
+// of the host succeeded.
+func (m *Prober) onHostProbed(item *workItem) {
+	if !item.done.CompareAndSwap(false, true) {
+		return
+	}
+	if item.succeeded.Load() {
+		item.hostState.succeeded.Store(true)
+	}
+	if item.hostState.pendingCount.Add(-1) == 0 && item.hostState.succeeded.Load() && m.hostReadyCallback != nil {
+		m.hostReadyCallback(item.ingressState.ing, item.url.Hostname())
+	}
+}
+
 func (m *Prober) probeVerifier(item *workItem) prober.Verifier {
 	return func(r *http.Response, _ []byte) (bool, error) {
+		// A certificate not matching the probed host is reported as such whatever the response.
+		if err := m.verifyCertificate(item, r); err != nil {
+			return false, err
+		}
+
 		// In the happy path, the probe request is forwarded to Activator or Queue-Proxy and the response (HTTP 200)
 		// contains the "K-Network-Hash" header that can be compared with the expected hash. If the hashes match,
 		// probing is successful, if they don't match, a new probe will be sent later.
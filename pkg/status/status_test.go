@@ -17,26 +17,39 @@ limitations under the License.
 package status
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"runtime"
+	"runtime/pprof"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	"knative.dev/networking/pkg/apis/networking/v1alpha1"
 	"knative.dev/networking/pkg/http/header"
 	"knative.dev/networking/pkg/http/probe"
 	"knative.dev/networking/pkg/ingress"
+	"knative.dev/pkg/logging"
 
+	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 var ingTemplate = &v1alpha1.Ingress{
@@ -174,6 +187,187 @@ func TestProbeAllHosts(t *testing.T) {
 	}
 }
 
+func TestProbeHostReadiness(t *testing.T) {
+	const hostA = "foo.bar.com"
+	const hostB = "ksvc.test.dev"
+	var hostBEnabled atomic.Bool
+
+	ing := ingTemplate.DeepCopy()
+	ing.Spec.Rules[0].Hosts = append(ing.Spec.Rules[0].Hosts, hostB)
+	hash, err := ingress.InsertProbe(ing.DeepCopy())
+	if err != nil {
+		t.Fatal("Failed to insert probe:", err)
+	}
+
+	// Probes to hostA always succeed and probes to hostB only succeed if hostBEnabled is true
+	probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == hostB && !hostBEnabled.Load() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.Header.Set(header.HashKey, hash)
+		probeHandler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+
+	ready := make(chan *v1alpha1.Ingress)
+	hostReady := make(chan string)
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithHostReadyCallback(func(_ *v1alpha1.Ingress, host string) {
+			hostReady <- host
+		}))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	if got := prober.HostsReady(ing); got != nil {
+		t.Errorf("HostsReady() = %v before probing, wanted nil", got)
+	}
+
+	ok, err := prober.IsReady(context.Background(), ing)
+	if err != nil {
+		t.Fatal("IsReady failed:", err)
+	}
+	if ok {
+		t.Fatal("IsReady() returned true")
+	}
+
+	select {
+	case host := <-hostReady:
+		if host != hostA {
+			t.Fatalf("Host %q became ready, wanted %q", host, hostA)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for hostA to become ready.")
+	}
+
+	if got, want := prober.HostsReady(ing), map[string]bool{hostA: true, hostB: false}; !cmp.Equal(got, want) {
+		t.Errorf("HostsReady() = %v, wanted %v", got, want)
+	}
+
+	// Make probes to hostB succeed
+	hostBEnabled.Store(true)
+
+	select {
+	case host := <-hostReady:
+		if host != hostB {
+			t.Fatalf("Host %q became ready, wanted %q", host, hostB)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for hostB to become ready.")
+	}
+
+	select {
+	case <-ready:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for probing to succeed.")
+	}
+
+	if got, want := prober.HostsReady(ing), map[string]bool{hostA: true, hostB: true}; !cmp.Equal(got, want) {
+		t.Errorf("HostsReady() = %v, wanted %v", got, want)
+	}
+}
+
+func TestProbeCustomPort(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   ProbeTarget
+		wantHost string
+	}{{
+		name:     "listener port",
+		target:   ProbeTarget{ListenerPort: "8080"},
+		wantHost: "foo.bar.com:8080",
+	}, {
+		name:     "default listener port",
+		target:   ProbeTarget{ListenerPort: "80"},
+		wantHost: "foo.bar.com",
+	}, {
+		// The Port of the existing listers doesn't change the probed host.
+		name:     "gateway port",
+		target:   ProbeTarget{Port: "8080"},
+		wantHost: "foo.bar.com",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ing := ingTemplate.DeepCopy()
+			hash, err := ingress.InsertProbe(ing.DeepCopy())
+			if err != nil {
+				t.Fatal("Failed to insert probe:", err)
+			}
+
+			// The listener only serves requests addressed to the wanted host.
+			probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Host != test.wantHost {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				r.Header.Set(header.HashKey, hash)
+				probeHandler.ServeHTTP(w, r)
+			}))
+			defer ts.Close()
+			tsURL, err := url.Parse(ts.URL)
+			if err != nil {
+				t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+			}
+
+			target := test.target
+			target.PodIPs = sets.New(tsURL.Hostname())
+			target.PodPort = tsURL.Port()
+			target.URLs = []*url.URL{{Scheme: "http"}}
+			ready := make(chan *v1alpha1.Ingress)
+			prober := NewProber(
+				zaptest.NewLogger(t).Sugar(),
+				fakeProbeTargetLister{target},
+				func(ing *v1alpha1.Ingress) {
+					ready <- ing
+				})
+
+			done := make(chan struct{})
+			cancelled := prober.Start(done)
+			defer func() {
+				close(done)
+				<-cancelled
+			}()
+
+			if ok, err := prober.IsReady(context.Background(), ing); err != nil {
+				t.Fatal("IsReady failed:", err)
+			} else if ok {
+				t.Fatal("IsReady() returned true")
+			}
+
+			select {
+			case <-ready:
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for probing to succeed.")
+			}
+		})
+	}
+}
+
 func TestProbeLifecycle(t *testing.T) {
 	ing := ingTemplate.DeepCopy()
 	hash, err := ingress.InsertProbe(ing.DeepCopy())
@@ -577,6 +771,135 @@ func TestPartialPodCancellation(t *testing.T) {
 	}
 }
 
+func TestCancelMultiPortPodProbing(t *testing.T) {
+	ing := ingTemplate.DeepCopy()
+	hash, err := ingress.InsertProbe(ing.DeepCopy())
+	if err != nil {
+		t.Fatal("Failed to insert probe:", err)
+	}
+
+	// The ready port returns HTTP 200 OK and the correct hash,
+	// the other port mimics a listener not ready.
+	readyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(header.HashKey, hash)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer readyServer.Close()
+	notReadyRequests := make(chan struct{}, 100)
+	notReadyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case notReadyRequests <- struct{}{}:
+		default:
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer notReadyServer.Close()
+
+	readyURL, err := url.Parse(readyServer.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", readyServer.URL, err)
+	}
+	notReadyURL, err := url.Parse(notReadyServer.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", notReadyServer.URL, err)
+	}
+	// Both servers listen on the same IP, as the ports of a single Pod.
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "pod",
+		},
+		Status: v1.PodStatus{
+			PodIP: readyURL.Hostname(),
+		},
+	}
+	otherPod := pod.DeepCopy()
+	otherPod.Status.PodIP = "198.51.100.1"
+
+	tests := []struct {
+		name      string
+		cancel    func(*Prober)
+		wantReady bool
+	}{{
+		name: "cancel the not ready port",
+		cancel: func(p *Prober) {
+			p.CancelPodPortProbing(pod, notReadyURL.Port())
+		},
+		wantReady: true,
+	}, {
+		name: "cancel the ready port",
+		cancel: func(p *Prober) {
+			p.CancelPodPortProbing(pod, readyURL.Port())
+		},
+	}, {
+		name: "cancel another pod with the same port",
+		cancel: func(p *Prober) {
+			p.CancelPodPortProbing(otherPod, notReadyURL.Port())
+		},
+	}, {
+		name: "cancel all the ports",
+		cancel: func(p *Prober) {
+			p.CancelPodProbing(pod)
+		},
+		wantReady: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ready := make(chan *v1alpha1.Ingress, 1)
+			prober := NewProber(
+				zaptest.NewLogger(t).Sugar(),
+				fakeProbeTargetLister{{
+					PodIPs:  sets.New(pod.Status.PodIP),
+					PodPort: readyURL.Port(),
+					URLs:    []*url.URL{readyURL},
+				}, {
+					PodIPs:  sets.New(pod.Status.PodIP),
+					PodPort: notReadyURL.Port(),
+					URLs:    []*url.URL{notReadyURL},
+				}},
+				func(ing *v1alpha1.Ingress) {
+					ready <- ing
+				})
+
+			done := make(chan struct{})
+			cancelled := prober.Start(done)
+			defer func() {
+				close(done)
+				<-cancelled
+			}()
+
+			ok, err := prober.IsReady(context.Background(), ing)
+			if err != nil {
+				t.Fatal("IsReady failed:", err)
+			}
+			if ok {
+				t.Fatal("IsReady() returned true")
+			}
+
+			select {
+			case <-notReadyRequests:
+				// Wait for the first probe request to the port not ready
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for a probe request.")
+			}
+
+			test.cancel(prober)
+
+			select {
+			case <-ready:
+				if !test.wantReady {
+					t.Fatal("Probing succeeded while it should not have succeeded")
+				}
+			case <-time.After(time.Second):
+				if test.wantReady {
+					t.Fatal("Probing was not successful even after waiting")
+				}
+			}
+		})
+	}
+}
+
 func TestCancelIngressProbing(t *testing.T) {
 	ing := ingTemplate.DeepCopy()
 	// Handler keeping track of received requests and mimicking an Ingress not ready
@@ -673,6 +996,1331 @@ func TestCancelIngressProbing(t *testing.T) {
 	}
 }
 
+func TestCancelIngressProbingNotReady(t *testing.T) {
+	ing := ingTemplate.DeepCopy()
+	// Handler mimicking an Ingress never ready
+	requests := make(chan struct{}, 100)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		select {
+		case requests <- struct{}{}:
+		default:
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+
+	recorder := record.NewFakeRecorder(10)
+	ready := make(chan *v1alpha1.Ingress, 1)
+	hostReady := make(chan string, 1)
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithInitialDelay(0),
+		WithEventRecorder(recorder),
+		WithHostReadyCallback(func(_ *v1alpha1.Ingress, host string) {
+			hostReady <- host
+		}))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	if ok, err := prober.IsReady(context.Background(), ing); err != nil {
+		t.Fatal("IsReady failed:", err)
+	} else if ok {
+		t.Fatal("IsReady() returned true")
+	}
+
+	select {
+	case <-requests:
+		// Wait for the first probe request
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the first probe request.")
+	}
+
+	prober.CancelIngressProbing(ing)
+
+	// Cancelling the probing must not report the Ingress ready.
+	select {
+	case <-ready:
+		t.Fatal("Ingress reported ready after its probing was cancelled")
+	case host := <-hostReady:
+		t.Fatalf("Host %q reported ready after its probing was cancelled", host)
+	case event := <-recorder.Events:
+		t.Fatal("Unexpected event after the probing was cancelled:", event)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestCancelPodProbingHostNotReady(t *testing.T) {
+	ing := ingTemplate.DeepCopy()
+	// Handler mimicking an Ingress never ready
+	requests := make(chan struct{}, 100)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		select {
+		case requests <- struct{}{}:
+		default:
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "gateway",
+		},
+		Status: v1.PodStatus{
+			PodIP: tsURL.Hostname(),
+		},
+	}
+
+	ready := make(chan *v1alpha1.Ingress, 1)
+	hostReady := make(chan string, 1)
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithInitialDelay(0),
+		WithHostReadyCallback(func(_ *v1alpha1.Ingress, host string) {
+			hostReady <- host
+		}))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	if ok, err := prober.IsReady(context.Background(), ing); err != nil {
+		t.Fatal("IsReady failed:", err)
+	} else if ok {
+		t.Fatal("IsReady() returned true")
+	}
+
+	select {
+	case <-requests:
+		// Wait for the first probe request
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the first probe request.")
+	}
+
+	// The Pod going away unblocks the Ingress, but its host was never successfully probed.
+	prober.CancelPodProbing(pod)
+	select {
+	case <-ready:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the Ingress to be ready.")
+	}
+
+	select {
+	case host := <-hostReady:
+		t.Fatalf("Host %q reported ready while none of its probes succeeded", host)
+	default:
+	}
+	if got, want := prober.HostsReady(ing), map[string]bool{ing.Spec.Rules[0].Hosts[0]: false}; !cmp.Equal(got, want) {
+		t.Errorf("HostsReady() = %v, wanted %v", got, want)
+	}
+}
+
+func TestStateSweeping(t *testing.T) {
+	// Handler mimicking an Ingress never ready
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+	hostname := tsURL.Hostname()
+
+	const ttl = 200 * time.Millisecond
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(hostname),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(*v1alpha1.Ingress) {},
+		WithStateTTL(ttl))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+	baseline := runtime.NumGoroutine()
+
+	stale := ingTemplate.DeepCopy()
+	stale.Name = "stale"
+	fresh := ingTemplate.DeepCopy()
+	fresh.Name = "fresh"
+	fresh.Spec.Rules[0].Hosts[0] = "fresh.bar.com"
+	for _, ing := range []*v1alpha1.Ingress{stale, fresh} {
+		if _, err := prober.IsReady(context.Background(), ing); err != nil {
+			t.Fatal("IsReady failed:", err)
+		}
+	}
+
+	// Keep accessing the fresh Ingress over several TTLs.
+	for range 20 {
+		if _, err := prober.IsReady(context.Background(), fresh); err != nil {
+			t.Fatal("IsReady failed:", err)
+		}
+		time.Sleep(ttl / 4)
+	}
+	if got := prober.HostsReady(stale); got != nil {
+		t.Errorf("HostsReady(stale) = %v, wanted the state to be evicted", got)
+	}
+	if got := prober.HostsReady(fresh); got == nil {
+		t.Error("HostsReady(fresh) = nil, wanted the state to be kept")
+	}
+	if got := podContextCount(prober); got != 1 {
+		t.Errorf("Got %d Pod contexts, wanted the one still probed to be kept", got)
+	}
+
+	// Stop accessing the fresh Ingress.
+	if err := waitFor(func() bool {
+		return prober.HostsReady(fresh) == nil && podContextCount(prober) == 0
+	}); err != nil {
+		t.Fatal("The fresh Ingress and its Pod contexts were not evicted:", err)
+	}
+	if err := waitFor(func() bool {
+		return runtime.NumGoroutine() <= baseline
+	}); err != nil {
+		buf := &bytes.Buffer{}
+		pprof.Lookup("goroutine").WriteTo(buf, 1)
+		t.Fatalf("Got %d goroutines, wanted at most %d:\n%s", runtime.NumGoroutine(), baseline, buf)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	var readyCalls atomic.Int32
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New("198.51.100.1"),
+			PodPort: "8080",
+			URLs:    []*url.URL{{Scheme: "http", Host: "foo.bar.com"}},
+		}},
+		func(*v1alpha1.Ingress) {
+			readyCalls.Add(1)
+		})
+
+	ing := ingTemplate.DeepCopy()
+	ready := ingTemplate.DeepCopy()
+	ready.Name = "ready"
+	for _, ing := range []*v1alpha1.Ingress{ing, ready} {
+		if _, err := prober.IsReady(context.Background(), ing); err != nil {
+			t.Fatal("IsReady failed:", err)
+		}
+	}
+	prober.mu.Lock()
+	prober.ingressStates[types.NamespacedName{Namespace: ready.Namespace, Name: ready.Name}].pendingCount.Store(0)
+	prober.mu.Unlock()
+
+	prober.sweep(time.Now().Add(-time.Hour), time.Now().Add(-time.Hour))
+	if got := prober.HostsReady(ing); got == nil {
+		t.Error("HostsReady() = nil, wanted the state accessed since to be kept")
+	}
+	prober.sweep(time.Now().Add(time.Hour), time.Now().Add(-time.Hour))
+	if got := prober.HostsReady(ing); got != nil {
+		t.Errorf("HostsReady() = %v, wanted the state to be evicted", got)
+	}
+	if got := podContextCount(prober); got != 0 {
+		t.Errorf("Got %d Pod contexts, wanted none", got)
+	}
+	if got := prober.HostsReady(ready); got == nil {
+		t.Error("HostsReady() = nil, wanted the state of the ready Ingress to be kept")
+	}
+
+	// The state of the ready Ingress is evicted past its longer TTL.
+	prober.sweep(time.Now().Add(time.Hour), time.Now().Add(time.Hour))
+	if got := prober.HostsReady(ready); got != nil {
+		t.Errorf("HostsReady() = %v, wanted the state of the ready Ingress to be evicted", got)
+	}
+
+	// Evicting the states doesn't report the Ingresses ready.
+	time.Sleep(100 * time.Millisecond)
+	if got := readyCalls.Load(); got != 0 {
+		t.Errorf("readyCallback called %d times, wanted none", got)
+	}
+}
+
+func podContextCount(m *Prober) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.podContexts)
+}
+
+// waitFor polls the given condition until it is met, or a timeout elapses.
+func waitFor(cond func() bool) error {
+	for deadline := time.Now().Add(5 * time.Second); !cond(); {
+		if time.Now().After(deadline) {
+			return errors.New("timed out waiting for the condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return nil
+}
+
+func TestReadinessDeadline(t *testing.T) {
+	const (
+		hostA    = "foo.bar.com"
+		hostB    = "ksvc.test.dev"
+		deadline = 300 * time.Millisecond
+	)
+
+	ing := ingTemplate.DeepCopy()
+	ing.Spec.Rules[0].Hosts = []string{hostA, hostB}
+	hash, err := ingress.InsertProbe(ing.DeepCopy())
+	if err != nil {
+		t.Fatal("Failed to insert probe:", err)
+	}
+
+	tests := []struct {
+		name       string
+		hostBReady bool
+		wantReason string
+	}{{
+		name:       "ready before the deadline",
+		hostBReady: true,
+	}, {
+		name:       "not ready at the deadline",
+		wantReason: "1 of 2 probes not successful after 300ms: http://ksvc.test.dev on %s (status 404: unexpected status code: want 200, got 404)",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var hostBReady atomic.Bool
+			hostBReady.Store(test.hostBReady)
+			probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Host == hostB && !hostBReady.Load() {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				r.Header.Set(header.HashKey, hash)
+				probeHandler.ServeHTTP(w, r)
+			}))
+			defer ts.Close()
+			tsURL, err := url.Parse(ts.URL)
+			if err != nil {
+				t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+			}
+
+			ready := make(chan *v1alpha1.Ingress)
+			notReady := make(chan string, 1)
+			prober := NewProber(
+				zaptest.NewLogger(t).Sugar(),
+				fakeProbeTargetLister{{
+					PodIPs:  sets.New(tsURL.Hostname()),
+					PodPort: tsURL.Port(),
+					URLs:    []*url.URL{tsURL},
+				}},
+				func(ing *v1alpha1.Ingress) {
+					ready <- ing
+				},
+				WithInitialDelay(0),
+				WithReadinessDeadline(deadline, func(_ *v1alpha1.Ingress, reason string) {
+					notReady <- reason
+				}))
+
+			done := make(chan struct{})
+			cancelled := prober.Start(done)
+			defer func() {
+				close(done)
+				<-cancelled
+			}()
+
+			if _, err := prober.IsReady(context.Background(), ing); err != nil {
+				t.Fatal("IsReady failed:", err)
+			}
+
+			if test.wantReason == "" {
+				select {
+				case <-ready:
+				case <-time.After(5 * time.Second):
+					t.Fatal("Timed out waiting for probing to succeed.")
+				}
+				select {
+				case reason := <-notReady:
+					t.Fatal("notReadyCallback invoked for a ready Ingress:", reason)
+				case <-time.After(2 * deadline):
+				}
+				return
+			}
+
+			select {
+			case reason := <-notReady:
+				if want := fmt.Sprintf(test.wantReason, tsURL.Host); reason != want {
+					t.Errorf("reason = %q, want: %q", reason, want)
+				}
+			case <-ready:
+				t.Fatal("Prober shouldn't be ready")
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for notReadyCallback.")
+			}
+
+			// The probing goes on past the deadline
+			hostBReady.Store(true)
+			select {
+			case <-ready:
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for probing to succeed.")
+			}
+		})
+	}
+}
+
+func TestProbeEvents(t *testing.T) {
+	// Every probe fails a few times before succeeding
+	const failures = 5
+
+	ing := ingTemplate.DeepCopy()
+	hash, err := ingress.InsertProbe(ing.DeepCopy())
+	if err != nil {
+		t.Fatal("Failed to insert probe:", err)
+	}
+
+	tests := []struct {
+		name    string
+		handler func(w http.ResponseWriter, r *http.Request)
+		dialErr error
+		// wantWarning is the expected Warning event, up to the Pod address
+		wantWarning string
+	}{{
+		name: "unexpected status",
+		handler: func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		},
+		wantWarning: "Warning ProbeUnexpectedStatus Probing of http://foo.bar.com on Pod %s failed 3 times: " +
+			"unexpected status code: want 200, got 404",
+	}, {
+		name: "hash mismatch",
+		handler: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set(header.HashKey, "nope")
+			w.WriteHeader(http.StatusOK)
+		},
+		wantWarning: fmt.Sprintf("Warning ProbeHashMismatch Probing of http://foo.bar.com on Pod %%s failed 3 times: "+
+			"unexpected hash: want %q, got \"nope\"", hash),
+	}, {
+		name:    "connection failure",
+		dialErr: errors.New("connection refused"),
+		wantWarning: "Warning ProbeConnectionFailed Probing of http://foo.bar.com on Pod %s failed 3 times: " +
+			"error roundtripping http://foo.bar.com/healthz: connection refused",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if test.handler != nil && attempts.Add(1) <= failures {
+					test.handler(w, r)
+					return
+				}
+				r.Header.Set(header.HashKey, hash)
+				probeHandler.ServeHTTP(w, r)
+			}))
+			defer ts.Close()
+			tsURL, err := url.Parse(ts.URL)
+			if err != nil {
+				t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+			}
+
+			recorder := record.NewFakeRecorder(10)
+			ready := make(chan *v1alpha1.Ingress)
+			var prober *Prober
+			prober = NewProber(
+				zaptest.NewLogger(t).Sugar(),
+				fakeProbeTargetLister{{
+					PodIPs:  sets.New(tsURL.Hostname()),
+					PodPort: tsURL.Port(),
+					URLs:    []*url.URL{tsURL},
+				}},
+				func(ing *v1alpha1.Ingress) {
+					ready <- ing
+				},
+				WithInitialDelay(0),
+				WithBaseRetryDelay(time.Millisecond),
+				WithEventRecorder(recorder),
+				WithTransportFactory(func(podIP, podPort string) http.RoundTripper {
+					transport := prober.newTransport(podIP, podPort)
+					return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+						if test.dialErr != nil && attempts.Add(1) <= failures {
+							return nil, test.dialErr
+						}
+						return transport.RoundTrip(r)
+					})
+				}))
+
+			done := make(chan struct{})
+			cancelled := prober.Start(done)
+			defer func() {
+				close(done)
+				<-cancelled
+			}()
+
+			if _, err := prober.IsReady(context.Background(), ing); err != nil {
+				t.Fatal("IsReady failed:", err)
+			}
+			select {
+			case <-ready:
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for probing to succeed.")
+			}
+
+			// A single Warning event is emitted for the failures, as they are rate limited.
+			close(recorder.Events)
+			var got []string
+			for event := range recorder.Events {
+				got = append(got, event)
+			}
+			want := []string{
+				fmt.Sprintf(test.wantWarning, tsURL.Host),
+				"Normal ProbingSucceeded Probing succeeded on 1 Pod port(s)",
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Error("Unexpected events (-want, +got):", diff)
+			}
+		})
+	}
+}
+
+func TestProberOptions(t *testing.T) {
+	rateLimiter := workqueue.DefaultTypedControllerRateLimiter[any]()
+	factory := func(string, string) http.RoundTripper { return http.DefaultTransport }
+
+	prober := NewProber(zaptest.NewLogger(t).Sugar(), notFoundLister{}, func(*v1alpha1.Ingress) {},
+		WithProbeConcurrency(3),
+		WithProbeTimeout(5*time.Second),
+		WithInitialDelay(0),
+		WithBaseRetryDelay(time.Second),
+		WithMaxRetryDelay(time.Minute),
+		WithQPS(10, 20),
+		WithRateLimiter(rateLimiter),
+		WithTransportFactory(factory),
+		WithStateTTL(time.Hour))
+	if got, want := prober.probeConcurrency, 3; got != want {
+		t.Errorf("probeConcurrency = %d, want: %d", got, want)
+	}
+	if got, want := prober.probeTimeout, 5*time.Second; got != want {
+		t.Errorf("probeTimeout = %v, want: %v", got, want)
+	}
+	if got, want := prober.initialDelay, time.Duration(0); got != want {
+		t.Errorf("initialDelay = %v, want: %v", got, want)
+	}
+	if got, want := prober.baseRetryDelay, time.Second; got != want {
+		t.Errorf("baseRetryDelay = %v, want: %v", got, want)
+	}
+	if got, want := prober.maxRetryDelay, time.Minute; got != want {
+		t.Errorf("maxRetryDelay = %v, want: %v", got, want)
+	}
+	if prober.qps != 10 || prober.burst != 20 {
+		t.Errorf("qps, burst = %v, %d, want: 10, 20", prober.qps, prober.burst)
+	}
+	if prober.rateLimiter != rateLimiter {
+		t.Error("rateLimiter was not set")
+	}
+	if prober.transportFactory == nil {
+		t.Error("transportFactory was not set")
+	}
+}
+
+func TestProberDefaults(t *testing.T) {
+	prober := NewProber(zaptest.NewLogger(t).Sugar(), notFoundLister{}, func(*v1alpha1.Ingress) {})
+	if got, want := prober.probeConcurrency, 15; got != want {
+		t.Errorf("probeConcurrency = %d, want: %d", got, want)
+	}
+	if got, want := prober.probeTimeout, time.Second; got != want {
+		t.Errorf("probeTimeout = %v, want: %v", got, want)
+	}
+	if got, want := prober.initialDelay, 200*time.Millisecond; got != want {
+		t.Errorf("initialDelay = %v, want: %v", got, want)
+	}
+	if got, want := prober.baseRetryDelay, 50*time.Millisecond; got != want {
+		t.Errorf("baseRetryDelay = %v, want: %v", got, want)
+	}
+	if got, want := prober.maxRetryDelay, probeMaxRetryDelay; got != want {
+		t.Errorf("maxRetryDelay = %v, want: %v", got, want)
+	}
+	if prober.qps != 50 || prober.burst != 100 {
+		t.Errorf("qps, burst = %v, %d, want: 50, 100", prober.qps, prober.burst)
+	}
+}
+
+func TestInvalidProberOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ProberOption
+		want string
+	}{{
+		name: "concurrency",
+		opts: []ProberOption{WithProbeConcurrency(0)},
+		want: "probe concurrency must be positive, got 0",
+	}, {
+		name: "timeout",
+		opts: []ProberOption{WithProbeTimeout(0)},
+		want: "probe timeout must be positive, got 0s",
+	}, {
+		name: "initial delay",
+		opts: []ProberOption{WithInitialDelay(-time.Second)},
+		want: "initial delay must not be negative, got -1s",
+	}, {
+		name: "base retry delay",
+		opts: []ProberOption{WithBaseRetryDelay(0)},
+		want: "base retry delay must be positive, got 0s",
+	}, {
+		name: "max retry delay",
+		opts: []ProberOption{WithBaseRetryDelay(time.Second), WithMaxRetryDelay(time.Millisecond)},
+		want: "max retry delay must be at least the base retry delay 1s, got 1ms",
+	}, {
+		name: "qps",
+		opts: []ProberOption{WithQPS(0, 0)},
+		want: "QPS must be positive, got 0\nburst must be positive, got 0",
+	}, {
+		name: "state ttl",
+		opts: []ProberOption{WithStateTTL(-time.Second)},
+		want: "state TTL must not be negative, got -1s",
+	}, {
+		name: "readiness deadline",
+		opts: []ProberOption{WithReadinessDeadline(-time.Second, func(*v1alpha1.Ingress, string) {})},
+		want: "readiness deadline must not be negative, got -1s",
+	}, {
+		name: "readiness deadline without callback",
+		opts: []ProberOption{WithReadinessDeadline(time.Second, nil)},
+		want: "readiness deadline requires a not ready callback",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			want := "invalid Prober options: " + test.want
+			m, err := NewProberWithOptions(zaptest.NewLogger(t).Sugar(), notFoundLister{}, func(*v1alpha1.Ingress) {}, test.opts...)
+			if err == nil || err.Error() != want || m != nil {
+				t.Errorf("NewProberWithOptions() = %v, %v, want: nil, %s", m, err, want)
+			}
+
+			// NewProber falls back to the defaults of the invalid options.
+			m = NewProber(zaptest.NewLogger(t).Sugar(), notFoundLister{}, func(*v1alpha1.Ingress) {}, test.opts...)
+			if err := m.validate(); err != nil {
+				t.Error("NewProber() kept invalid options:", err)
+			}
+		})
+	}
+}
+
+func TestProbeTransportFactory(t *testing.T) {
+	ing := ingTemplate.DeepCopy()
+	hash, err := ingress.InsertProbe(ing.DeepCopy())
+	if err != nil {
+		t.Fatal("Failed to insert probe:", err)
+	}
+
+	// The RoundTripper mimics a ready Pod without any network connection.
+	// The factory calls back into the Prober, which must not hold its lock.
+	var prober *Prober
+	dialed := make(chan string, 10)
+	factory := func(podIP, podPort string) http.RoundTripper {
+		prober.HostsReady(ing)
+		return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			dialed <- net.JoinHostPort(podIP, podPort)
+			h := http.Header{}
+			h.Set(header.HashKey, hash)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     h,
+				Body:       http.NoBody,
+				Request:    r,
+			}, nil
+		})
+	}
+
+	ready := make(chan *v1alpha1.Ingress)
+	prober = NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New("198.51.100.1"),
+			PodPort: "8080",
+			URLs:    []*url.URL{{Scheme: "http", Host: "foo.bar.com"}},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithTransportFactory(factory),
+		WithInitialDelay(0))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	if _, err := prober.IsReady(context.Background(), ing); err != nil {
+		t.Fatal("IsReady failed:", err)
+	}
+	select {
+	case <-ready:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Probing was not successful even after waiting")
+	}
+	if got, want := <-dialed, "198.51.100.1:8080"; got != want {
+		t.Errorf("Transport created for %s, want: %s", got, want)
+	}
+}
+
+func TestProbeTransportCache(t *testing.T) {
+	// The handler replies with the hash of the Ingress being probed.
+	var hash atomic.Value
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(header.HashKey, hash.Load().(string))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "pod",
+		},
+		Status: v1.PodStatus{
+			PodIP: tsURL.Hostname(),
+		},
+	}
+
+	var created, closed atomic.Int32
+	var prober *Prober
+	factory := func(podIP, podPort string) http.RoundTripper {
+		created.Add(1)
+		return &closeRecorder{
+			RoundTripper: prober.newTransport(podIP, podPort),
+			closed:       &closed,
+		}
+	}
+	ready := make(chan *v1alpha1.Ingress)
+	prober = NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(pod.Status.PodIP),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithTransportFactory(factory),
+		WithInitialDelay(0))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	probe := func(name string) {
+		t.Helper()
+		ing := ingTemplate.DeepCopy()
+		ing.Name = name
+		h, err := ingress.InsertProbe(ing.DeepCopy())
+		if err != nil {
+			t.Fatal("Failed to insert probe:", err)
+		}
+		hash.Store(h)
+		if _, err := prober.IsReady(context.Background(), ing); err != nil {
+			t.Fatal("IsReady failed:", err)
+		}
+		select {
+		case <-ready:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Probing was not successful even after waiting")
+		}
+	}
+
+	// The probes of the Pod port share a transport.
+	probe("first")
+	probe("second")
+	if got := created.Load(); got != 1 {
+		t.Errorf("Created %d transports, want: 1", got)
+	}
+
+	// Cancelling the Pod evicts its transport.
+	prober.CancelPodProbing(pod)
+	if got := closed.Load(); got != 1 {
+		t.Errorf("Closed the idle connections of %d transports, want: 1", got)
+	}
+	probe("third")
+	if got := created.Load(); got != 2 {
+		t.Errorf("Created %d transports, want: 2", got)
+	}
+}
+
+func TestProbeConnectionReuse(t *testing.T) {
+	tests := []struct {
+		name      string
+		tls       bool
+		wantConns int32
+	}{{
+		name:      "plain HTTP probes reuse connections",
+		wantConns: 1,
+	}, {
+		// The connections opened for the former version of an Ingress aren't reused.
+		name:      "TLS probes reuse connections within an Ingress version",
+		tls:       true,
+		wantConns: 2,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var hash atomic.Value
+			var conns atomic.Int32
+			// The first attempt of every probe fails, so that it is retried.
+			var failNext atomic.Bool
+			ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if failNext.CompareAndSwap(true, false) {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Header().Set(header.HashKey, hash.Load().(string))
+				w.WriteHeader(http.StatusOK)
+			}))
+			ts.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					conns.Add(1)
+				}
+			}
+			if test.tls {
+				ts.StartTLS()
+			} else {
+				ts.Start()
+			}
+			defer ts.Close()
+			tsURL, err := url.Parse(ts.URL)
+			if err != nil {
+				t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+			}
+
+			ready := make(chan *v1alpha1.Ingress)
+			prober := NewProber(
+				zaptest.NewLogger(t).Sugar(),
+				fakeProbeTargetLister{{
+					PodIPs:  sets.New(tsURL.Hostname()),
+					PodPort: tsURL.Port(),
+					URLs:    []*url.URL{tsURL},
+				}},
+				func(ing *v1alpha1.Ingress) {
+					ready <- ing
+				},
+				WithInitialDelay(0),
+				WithBaseRetryDelay(time.Millisecond))
+
+			done := make(chan struct{})
+			cancelled := prober.Start(done)
+			defer func() {
+				close(done)
+				<-cancelled
+			}()
+
+			for _, name := range []string{"first", "second"} {
+				ing := ingTemplate.DeepCopy()
+				ing.Name = name
+				h, err := ingress.InsertProbe(ing.DeepCopy())
+				if err != nil {
+					t.Fatal("Failed to insert probe:", err)
+				}
+				hash.Store(h)
+				failNext.Store(true)
+				if _, err := prober.IsReady(context.Background(), ing); err != nil {
+					t.Fatal("IsReady failed:", err)
+				}
+				select {
+				case <-ready:
+				case <-time.After(5 * time.Second):
+					t.Fatal("Probing was not successful even after waiting")
+				}
+			}
+			if got := conns.Load(); got != test.wantConns {
+				t.Errorf("Opened %d connections, want: %d", got, test.wantConns)
+			}
+		})
+	}
+}
+
+func TestBatchedProbing(t *testing.T) {
+	ingA := ingTemplate.DeepCopy()
+	ingA.Name = "ing-a"
+	ingA.Spec.Rules[0].Hosts = make([]string, 10)
+	for i := range ingA.Spec.Rules[0].Hosts {
+		ingA.Spec.Rules[0].Hosts[i] = fmt.Sprintf("a%d.example.com", i)
+	}
+	ingB := ingTemplate.DeepCopy()
+	ingB.Name = "ing-b"
+	ingB.Spec.Rules[0].Hosts = []string{"b.example.com"}
+
+	hashes := make(map[string]string)
+	for _, ing := range []*v1alpha1.Ingress{ingA, ingB} {
+		hash, err := ingress.InsertProbe(ing.DeepCopy())
+		if err != nil {
+			t.Fatal("Failed to insert probe:", err)
+		}
+		for _, host := range ing.Spec.Rules[0].Hosts {
+			hashes[host] = hash
+		}
+	}
+
+	// Probes to the host of ingB only succeed once hostBEnabled is true
+	var (
+		hostBEnabled atomic.Bool
+		mu           sync.Mutex
+		requests     = make(map[string]int)
+	)
+	probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Host]++
+		mu.Unlock()
+		if r.Host == "b.example.com" && !hostBEnabled.Load() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.Header.Set(header.HashKey, hashes[r.Host])
+		probeHandler.ServeHTTP(w, r)
+	})
+
+	var targets fakeProbeTargetLister
+	for range 2 {
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+		tsURL, err := url.Parse(ts.URL)
+		if err != nil {
+			t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+		}
+		targets = append(targets, ProbeTarget{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		})
+	}
+
+	ready := make(chan *v1alpha1.Ingress)
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		targets,
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithBatchedProbing(),
+		WithInitialDelay(0),
+		WithBaseRetryDelay(10*time.Millisecond),
+		WithMaxRetryDelay(50*time.Millisecond))
+
+	for _, ing := range []*v1alpha1.Ingress{ingA, ingB} {
+		if ok, err := prober.IsReady(context.Background(), ing); err != nil {
+			t.Fatal("IsReady failed:", err)
+		} else if ok {
+			t.Fatalf("IsReady(%s) = true, want false", ing.Name)
+		}
+	}
+	// The probes of the two Ingresses are queued as a single work item per Pod.
+	if got, want := prober.workQueue.Len(), len(targets); got != want {
+		t.Errorf("Queue depth = %d, want %d", got, want)
+	}
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	select {
+	case ing := <-ready:
+		if ing != ingA {
+			t.Fatalf("Ready Ingress = %s, want %s", ing.Name, ingA.Name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the probing of ingA to succeed")
+	}
+
+	// Let the probes of ingB be retried a few times
+	if err := waitFor(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests["b.example.com"] > 2*len(targets)
+	}); err != nil {
+		t.Fatal("The probes of ingB were not retried:", err)
+	}
+	hostBEnabled.Store(true)
+
+	select {
+	case ing := <-ready:
+		if ing != ingB {
+			t.Fatalf("Ready Ingress = %s, want %s", ing.Name, ingB.Name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the probing of ingB to succeed")
+	}
+
+	// A single probe per Pod covers all the hosts of ingA, and it isn't sent again
+	// when the batches are retried for ingB.
+	mu.Lock()
+	defer mu.Unlock()
+	var got int
+	for _, host := range ingA.Spec.Rules[0].Hosts {
+		got += requests[host]
+	}
+	if want := len(targets); got != want {
+		t.Errorf("Probes of ingA = %d, want %d", got, want)
+	}
+	if err := waitFor(func() bool {
+		return batchCount(prober) == 0
+	}); err != nil {
+		t.Error("Batches were not evicted once drained:", err)
+	}
+}
+
+func TestBatchedProbingConcurrency(t *testing.T) {
+	const concurrency = 2
+
+	// A probe per Ingress is sent in each round.
+	ings := make([]*v1alpha1.Ingress, 10)
+	hashes := make(map[string]string, len(ings))
+	for i := range ings {
+		ings[i] = ingTemplate.DeepCopy()
+		ings[i].Name = fmt.Sprintf("ing-%d", i)
+		ings[i].Spec.Rules[0].Hosts = []string{fmt.Sprintf("host-%d.example.com", i)}
+		hash, err := ingress.InsertProbe(ings[i].DeepCopy())
+		if err != nil {
+			t.Fatal("Failed to insert probe:", err)
+		}
+		hashes[ings[i].Spec.Rules[0].Hosts[0]] = hash
+	}
+
+	var inFlight, maxInFlight atomic.Int32
+	probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		r.Header.Set(header.HashKey, hashes[r.Host])
+		probeHandler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+
+	ready := make(chan *v1alpha1.Ingress)
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithBatchedProbing(),
+		WithProbeConcurrency(concurrency),
+		WithInitialDelay(0))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	for _, ing := range ings {
+		if _, err := prober.IsReady(context.Background(), ing); err != nil {
+			t.Fatal("IsReady failed:", err)
+		}
+	}
+	for range ings {
+		select {
+		case <-ready:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for probing to succeed.")
+		}
+	}
+	if got := maxInFlight.Load(); got > concurrency {
+		t.Errorf("Got up to %d probes in flight, want at most %d", got, concurrency)
+	}
+}
+
+func TestBatchedProbingBackoff(t *testing.T) {
+	stuck := ingTemplate.DeepCopy()
+	stuck.Name = "stuck"
+	stuck.Spec.Rules[0].Hosts = []string{"stuck.example.com"}
+	fresh := ingTemplate.DeepCopy()
+	fresh.Name = "fresh"
+	fresh.Spec.Rules[0].Hosts = []string{"fresh.example.com"}
+	hash, err := ingress.InsertProbe(fresh.DeepCopy())
+	if err != nil {
+		t.Fatal("Failed to insert probe:", err)
+	}
+
+	// The probes of the stuck Ingress never succeed, the first probe of the fresh one fails.
+	var stuckRequests, freshRequests atomic.Int32
+	probeHandler := probe.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "stuck.example.com" {
+			stuckRequests.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if freshRequests.Add(1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		r.Header.Set(header.HashKey, hash)
+		probeHandler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	tsURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse URL %q: %v", ts.URL, err)
+	}
+
+	ready := make(chan *v1alpha1.Ingress)
+	prober := NewProber(
+		zaptest.NewLogger(t).Sugar(),
+		fakeProbeTargetLister{{
+			PodIPs:  sets.New(tsURL.Hostname()),
+			PodPort: tsURL.Port(),
+			URLs:    []*url.URL{tsURL},
+		}},
+		func(ing *v1alpha1.Ingress) {
+			ready <- ing
+		},
+		WithBatchedProbing(),
+		WithInitialDelay(0),
+		WithBaseRetryDelay(10*time.Millisecond),
+		WithMaxRetryDelay(time.Hour))
+
+	done := make(chan struct{})
+	cancelled := prober.Start(done)
+	defer func() {
+		close(done)
+		<-cancelled
+	}()
+
+	// Let the retry delay of the batch grow beyond 2s
+	if _, err := prober.IsReady(context.Background(), stuck); err != nil {
+		t.Fatal("IsReady failed:", err)
+	}
+	if err := waitFor(func() bool {
+		return stuckRequests.Load() >= 9
+	}); err != nil {
+		t.Fatal("The probes of the stuck Ingress were not retried:", err)
+	}
+
+	// The retry of the fresh Ingress doesn't wait for the delay reached by the stuck one.
+	if _, err := prober.IsReady(context.Background(), fresh); err != nil {
+		t.Fatal("IsReady failed:", err)
+	}
+	select {
+	case ing := <-ready:
+		if ing != fresh {
+			t.Fatalf("Ready Ingress = %s, want %s", ing.Name, fresh.Name)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the probing of the fresh Ingress to succeed")
+	}
+}
+
+// batchCount returns the number of batches of the Prober.
+func batchCount(m *Prober) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.batches)
+}
+
+func BenchmarkProbe(b *testing.B) {
+	const hostsPerIngress = 20
+
+	var hash atomic.Value
+	// The TLS server fails the first attempt of every probe, so that the benchmark
+	// covers the connections reused by the retries of a probe.
+	var attempted sync.Map
+	handler := func(tls bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if _, loaded := attempted.LoadOrStore(r.Host, struct{}{}); tls && !loaded {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set(header.HashKey, hash.Load().(string))
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+	servers := map[bool]*httptest.Server{
+		false: httptest.NewServer(handler(false)),
+		true:  httptest.NewTLSServer(handler(true)),
+	}
+	for _, ts := range servers {
+		defer ts.Close()
+	}
+
+	// transportPerProbe is the Transport the probes were sent with before connections were
+	// reused. Without keep-alives, every probe opens a new connection even if it is cached.
+	transportPerProbe := func(m *Prober) TransportFactory {
+		return func(podIP, podPort string) http.RoundTripper {
+			transport := m.newPodTransport(podIP, podPort)
+			transport.DisableKeepAlives = true
+			return transport
+		}
+	}
+
+	for _, bench := range []struct {
+		name    string
+		tls     bool
+		factory func(*Prober) TransportFactory
+		opts    []ProberOption
+	}{{
+		name:    "transport-per-probe",
+		factory: transportPerProbe,
+	}, {
+		name: "cached-transport",
+	}, {
+		name: "batched",
+		opts: []ProberOption{WithBatchedProbing()},
+	}, {
+		name:    "tls-transport-per-probe",
+		tls:     true,
+		factory: transportPerProbe,
+	}, {
+		name: "tls-cached-transport",
+		tls:  true,
+	}} {
+		b.Run(bench.name, func(b *testing.B) {
+			tsURL, err := url.Parse(servers[bench.tls].URL)
+			if err != nil {
+				b.Fatalf("Failed to parse URL %q: %v", servers[bench.tls].URL, err)
+			}
+
+			ready := make(chan *v1alpha1.Ingress)
+			opts := append([]ProberOption{
+				WithInitialDelay(0),
+				WithBaseRetryDelay(time.Millisecond),
+				WithQPS(math.MaxInt32, math.MaxInt32),
+			}, bench.opts...)
+			var prober *Prober
+			if bench.factory != nil {
+				opts = append(opts, WithTransportFactory(func(podIP, podPort string) http.RoundTripper {
+					return bench.factory(prober)(podIP, podPort)
+				}))
+			}
+			prober = NewProber(
+				zap.NewNop().Sugar(),
+				fakeProbeTargetLister{{
+					PodIPs:  sets.New(tsURL.Hostname()),
+					PodPort: tsURL.Port(),
+					URLs:    []*url.URL{tsURL},
+				}},
+				func(ing *v1alpha1.Ingress) {
+					ready <- ing
+				},
+				opts...)
+
+			done := make(chan struct{})
+			cancelled := prober.Start(done)
+			defer func() {
+				close(done)
+				<-cancelled
+			}()
+
+			ctx := logging.WithLogger(context.Background(), zap.NewNop().Sugar())
+			ing := ingTemplate.DeepCopy()
+			ing.Name = bench.name
+			ing.Spec.Rules[0].Hosts = make([]string, hostsPerIngress)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := range b.N {
+				for j := range ing.Spec.Rules[0].Hosts {
+					ing.Spec.Rules[0].Hosts[j] = fmt.Sprintf("%s-%d-%d.example.com", bench.name, i, j)
+				}
+				h, err := ingress.InsertProbe(ing.DeepCopy())
+				if err != nil {
+					b.Fatal("Failed to insert probe:", err)
+				}
+				hash.Store(h)
+				if _, err := prober.IsReady(ctx, ing); err != nil {
+					b.Fatal("IsReady failed:", err)
+				}
+				<-ready
+			}
+			b.ReportMetric(float64(b.N*hostsPerIngress)/b.Elapsed().Seconds(), "probes/s")
+		})
+	}
+}
+
+// closeRecorder counts the calls to CloseIdleConnections.
+type closeRecorder struct {
+	http.RoundTripper
+	closed *atomic.Int32
+}
+
+func (c *closeRecorder) CloseIdleConnections() {
+	c.closed.Add(1)
+	closeIdleConnections(c.RoundTripper)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
 func TestProbeVerifier(t *testing.T) {
 	const hash = "Hi! I am hash!"
 	prober := NewProber(zaptest.NewLogger(t).Sugar(), nil, nil)
@@ -771,9 +2419,10 @@ func (l fakeProbeTargetLister) ListProbeTargets(_ context.Context, ing *v1alpha1
 	targets := []ProbeTarget{}
 	for _, target := range l {
 		newTarget := ProbeTarget{
-			PodIPs:  target.PodIPs,
-			PodPort: target.PodPort,
-			Port:    target.Port,
+			PodIPs:       target.PodIPs,
+			PodPort:      target.PodPort,
+			Port:         target.Port,
+			ListenerPort: target.ListenerPort,
 		}
 		for _, url := range target.URLs {
 			for _, host := range ing.Spec.Rules[0].Hosts {
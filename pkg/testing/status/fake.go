@@ -24,14 +24,19 @@ import (
 	"knative.dev/networking/pkg/status"
 )
 
-// FakeStatusManager implements status.Manager for use in unit tests.
+// FakeStatusManager implements status.Manager and status.Reporter for use in unit tests.
 type FakeStatusManager struct {
 	FakeIsReady func(ctx context.Context, ing *v1alpha1.Ingress) (bool, error)
+	// FakeStatus is optional, Status returns nil if it isn't set.
+	FakeStatus func(ctx context.Context, ing *v1alpha1.Ingress) (*status.ProbingStatus, error)
 
 	isReadyCallCount map[types.NamespacedName]int
 }
 
-var _ status.Manager = (*FakeStatusManager)(nil)
+var (
+	_ status.Manager  = (*FakeStatusManager)(nil)
+	_ status.Reporter = (*FakeStatusManager)(nil)
+)
 
 // IsReady implements IsReady
 func (m *FakeStatusManager) IsReady(ctx context.Context, ing *v1alpha1.Ingress) (bool, error) {
@@ -50,3 +55,11 @@ func (m *FakeStatusManager) IsReadyCallCount(ing *v1alpha1.Ingress) int {
 	key := types.NamespacedName{Namespace: ing.Namespace, Name: ing.Name}
 	return m.isReadyCallCount[key]
 }
+
+// Status implements Status
+func (m *FakeStatusManager) Status(ctx context.Context, ing *v1alpha1.Ingress) (*status.ProbingStatus, error) {
+	if m.FakeStatus == nil {
+		return nil, nil
+	}
+	return m.FakeStatus(ctx, ing)
+}
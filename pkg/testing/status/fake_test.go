@@ -6,6 +6,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/pkg/status"
 )
 
 func TestCallsFakeIsReady(t *testing.T) {
@@ -71,3 +72,24 @@ func TestIsReadyNeverCalled(t *testing.T) {
 			statusManager.IsReadyCallCount(&ingress1), 0)
 	}
 }
+
+func TestCallsFakeStatus(t *testing.T) {
+	want := &status.ProbingStatus{Hash: "hash"}
+	statusManager := FakeStatusManager{
+		FakeStatus: func(context.Context, *v1alpha1.Ingress) (*status.ProbingStatus, error) {
+			return want, nil
+		},
+	}
+
+	if got, _ := statusManager.Status(context.Background(), &v1alpha1.Ingress{}); got != want {
+		t.Errorf("Status() = %v, want %v", got, want)
+	}
+}
+
+func TestFakeStatusNotSet(t *testing.T) {
+	statusManager := FakeStatusManager{}
+
+	if got, err := statusManager.Status(context.Background(), &v1alpha1.Ingress{}); got != nil || err != nil {
+		t.Errorf("Status() = %v, %v, want nil, nil", got, err)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation holds the validation of the Ingress settings that can be
+// set both on the Ingresses and as defaults in the config-network ConfigMap.
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis"
+)
+
+// HSTSPreloadMinMaxAge is the minimal max-age, in seconds, of the HSTS policies
+// asking to be preloaded, as required by the browsers' preload lists.
+const HSTSPreloadMinMaxAge = 31536000
+
+// supportedAccessLogFields are the fields that can be included in the access log entries.
+var supportedAccessLogFields = sets.New(
+	"authority", "bytesReceived", "bytesSent", "duration", "method",
+	"path", "protocol", "remoteAddress", "requestId", "startTime",
+	"status", "upstreamHost", "userAgent",
+)
+
+// HSTSPolicy validates the directives of an HSTS policy.
+func HSTSPolicy(maxAge int64, includeSubDomains, preload bool) *apis.FieldError {
+	var all *apis.FieldError
+	if maxAge < 0 {
+		all = all.Also(apis.ErrInvalidValue(maxAge, "maxAge"))
+	}
+	if preload {
+		if !includeSubDomains {
+			all = all.Also(&apis.FieldError{
+				Message: "preload requires includeSubDomains",
+				Paths:   []string{"preload"},
+			})
+		}
+		if maxAge < HSTSPreloadMinMaxAge {
+			all = all.Also(&apis.FieldError{
+				Message: fmt.Sprintf("preload requires a maxAge of at least %d seconds", HSTSPreloadMinMaxAge),
+				Paths:   []string{"preload"},
+			})
+		}
+	}
+	return all
+}
+
+// AccessLogFields validates the fields of the access log entries,
+// which must be supported and not repeated.
+func AccessLogFields(fields []string) *apis.FieldError {
+	var all *apis.FieldError
+	seen := make(sets.Set[string], len(fields))
+	for idx, field := range fields {
+		if !supportedAccessLogFields.Has(field) || seen.Has(field) {
+			all = all.Also(apis.ErrInvalidArrayValue(field, "fields", idx))
+		}
+		seen.Insert(field)
+	}
+	return all
+}
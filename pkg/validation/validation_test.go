@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"knative.dev/pkg/apis"
+)
+
+func TestHSTSPolicy(t *testing.T) {
+	tests := []struct {
+		name              string
+		maxAge            int64
+		includeSubDomains bool
+		preload           bool
+		want              *apis.FieldError
+	}{{
+		name:   "valid",
+		maxAge: 300,
+	}, {
+		name:              "valid preload",
+		maxAge:            HSTSPreloadMinMaxAge,
+		includeSubDomains: true,
+		preload:           true,
+	}, {
+		name:   "negative max-age",
+		maxAge: -1,
+		want:   apis.ErrInvalidValue(-1, "maxAge"),
+	}, {
+		name:    "preload",
+		maxAge:  300,
+		preload: true,
+		want: (&apis.FieldError{
+			Message: "preload requires includeSubDomains",
+			Paths:   []string{"preload"},
+		}).Also(&apis.FieldError{
+			Message: "preload requires a maxAge of at least 31536000 seconds",
+			Paths:   []string{"preload"},
+		}),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := HSTSPolicy(test.maxAge, test.includeSubDomains, test.preload)
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("HSTSPolicy (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestAccessLogFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []string
+		want   *apis.FieldError
+	}{{
+		name: "no fields",
+	}, {
+		name:   "valid",
+		fields: []string{"method", "path", "status"},
+	}, {
+		name:   "unsupported and repeated fields",
+		fields: []string{"method", "cookie", "method"},
+		want: apis.ErrInvalidArrayValue("cookie", "fields", 1).Also(
+			apis.ErrInvalidArrayValue("method", "fields", 2)),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := AccessLogFields(test.fields)
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("AccessLogFields (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/networking/pkg/apis/networking"
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/test"
+	"knative.dev/networking/test/types"
+	"knative.dev/pkg/ptr"
+)
+
+// TestCompression verifies that an Ingress compresses the responses of the
+// paths with a compression policy for the clients accepting it.
+func TestCompression(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	name, port, _ := CreateRuntimeService(ctx, t, clients, networking.ServicePortNameHTTP1)
+
+	backend := []v1alpha1.IngressBackendSplit{{
+		IngressBackend: v1alpha1.IngressBackend{
+			ServiceName:      name,
+			ServiceNamespace: test.ServingNamespace,
+			ServicePort:      intstr.FromInt(port),
+		},
+	}}
+
+	host := name + "." + test.NetworkingFlags.ServiceDomain
+	_, client, _ := CreateIngressReady(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{host},
+			Visibility: v1alpha1.IngressVisibilityExternalIP,
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					// The runtime image responds with JSON, which this path doesn't compress.
+					Path:   "/text",
+					Splits: backend,
+					Compression: &v1alpha1.CompressionPolicy{
+						Algorithms:   []v1alpha1.CompressionAlgorithm{v1alpha1.CompressionAlgorithmGzip},
+						ContentTypes: []string{"text/*"},
+					},
+				}, {
+					Splits: backend,
+					Compression: &v1alpha1.CompressionPolicy{
+						Algorithms: []v1alpha1.CompressionAlgorithm{v1alpha1.CompressionAlgorithmGzip},
+						// The runtime image responses are comfortably larger than that.
+						MinResponseBytes: ptr.Int64(256),
+						ContentTypes:     []string{"application/json"},
+					},
+				}},
+			},
+		}},
+	})
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptEncoding string
+		wantEncoding   string
+	}{{
+		name:           "gzip accepted",
+		path:           "/",
+		acceptEncoding: "gzip",
+		wantEncoding:   "gzip",
+	}, {
+		name:           "gzip not accepted",
+		path:           "/",
+		acceptEncoding: "identity",
+	}, {
+		name:           "content type not compressed",
+		path:           "/text",
+		acceptEncoding: "gzip",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequest(http.MethodGet, "http://"+host+test.path, nil)
+			if err != nil {
+				t.Fatal("Error creating Request:", err)
+			}
+			// Setting the header explicitly prevents the transport from
+			// transparently decompressing the response.
+			req.Header.Set("Accept-Encoding", test.acceptEncoding)
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal("Error making GET request:", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Unexpected status code: %d, wanted %d", resp.StatusCode, http.StatusOK)
+			}
+			if got := resp.Header.Get("Content-Encoding"); got != test.wantEncoding {
+				DumpResponse(ctx, t, resp)
+				t.Fatalf("Content-Encoding = %q, wanted %q", got, test.wantEncoding)
+			}
+
+			var body io.Reader = resp.Body
+			if test.wantEncoding == "gzip" {
+				zr, err := gzip.NewReader(resp.Body)
+				if err != nil {
+					t.Fatal("Unable to read gzip response body:", err)
+				}
+				defer zr.Close()
+				body = zr
+			}
+			ri := &types.RuntimeInfo{}
+			if err := json.NewDecoder(body).Decode(ri); err != nil {
+				t.Error("Unable to parse runtime image's response payload:", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/test"
+)
+
+// TestErrorPages verifies that an Ingress replaces the body of the error
+// responses it generates with the matching error page.
+func TestErrorPages(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	name, port, _ := CreateTimeoutService(ctx, t, clients)
+
+	const (
+		timeout     = time.Second
+		body        = "<h1>The service took too long to respond.</h1>"
+		contentType = "text/html; charset=utf-8"
+	)
+
+	_, client, _ := CreateIngressReady(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{name + "." + test.NetworkingFlags.ServiceDomain},
+			Visibility: v1alpha1.IngressVisibilityExternalIP,
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					Splits: []v1alpha1.IngressBackendSplit{{
+						IngressBackend: v1alpha1.IngressBackend{
+							ServiceName:      name,
+							ServiceNamespace: test.ServingNamespace,
+							ServicePort:      intstr.FromInt(port),
+						},
+					}},
+					ResponseTimeout: &metav1.Duration{Duration: timeout},
+				}},
+			},
+		}},
+		ErrorPages: []v1alpha1.ErrorPage{{
+			StatusCodes: v1alpha1.StatusCodeRange{From: http.StatusGatewayTimeout},
+			Inline: &v1alpha1.InlineErrorPage{
+				Body:        body,
+				ContentType: contentType,
+			},
+		}},
+	})
+
+	// The backend delays its response headers beyond the timeout of the path,
+	// so the Ingress responds with a 504 itself.
+	resp, err := client.Get(fmt.Sprintf("http://%s.%s?initialTimeout=%d",
+		name, test.NetworkingFlags.ServiceDomain, (5 * timeout).Milliseconds()))
+	if err != nil {
+		t.Fatal("Error making GET request:", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusGatewayTimeout {
+		DumpResponse(ctx, t, resp)
+		t.Fatalf("Unexpected status code: %d, wanted %d", resp.StatusCode, http.StatusGatewayTimeout)
+	}
+	if got := resp.Header.Get("Content-Type"); got != contentType {
+		t.Errorf("Content-Type = %q, wanted %q", got, contentType)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal("Unable to read response body:", err)
+	}
+	if got := string(b); got != body {
+		t.Errorf("Body = %q, wanted %q", got, body)
+	}
+
+	// Responses within the timeout are left as is.
+	checkTimeout(ctx, t, client, name, http.StatusOK, 0, 0)
+}
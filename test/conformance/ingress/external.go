@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/networking/pkg/apis/networking"
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/test"
+)
+
+// TestExternalBackend verifies that an Ingress path can route traffic to a
+// destination referenced by DNS name instead of a Service in the cluster.
+func TestExternalBackend(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	name, port, _ := CreateRuntimeService(ctx, t, clients, networking.ServicePortNameHTTP1)
+
+	privateServiceName := test.ObjectNameForTest(t)
+	privateHostName := privateServiceName + "." + test.ServingNamespace + ".svc." + test.NetworkingFlags.ClusterSuffix
+
+	// Create a simple cluster-local Ingress over the Service, which acts as
+	// the "external" destination below.
+	ing, _, _ := CreateIngressReady(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Visibility: v1alpha1.IngressVisibilityClusterLocal,
+			Hosts:      []string{privateHostName},
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					Splits: []v1alpha1.IngressBackendSplit{{
+						IngressBackend: v1alpha1.IngressBackend{
+							ServiceName:      name,
+							ServiceNamespace: test.ServingNamespace,
+							ServicePort:      intstr.FromInt(port),
+						},
+					}},
+				}},
+			},
+		}},
+	})
+	loadbalancerAddress := ing.Status.PrivateLoadBalancer.Ingress[0].DomainInternal
+
+	t.Run("externalname", func(t *testing.T) {
+		t.Parallel()
+
+		// Slap an ExternalName service in front of the kingress, so that the
+		// private host name resolves to the load balancer.
+		createExternalNameService(ctx, t, clients, privateHostName, loadbalancerAddress)
+
+		testExternalBackend(ctx, t, clients, &v1alpha1.ExternalBackend{
+			Host:   privateHostName,
+			Port:   80,
+			Scheme: "http",
+		})
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		t.Parallel()
+
+		proxyName, proxyPort, _ := CreateProxyService(ctx, t, clients, privateHostName, loadbalancerAddress)
+
+		testExternalBackend(ctx, t, clients, &v1alpha1.ExternalBackend{
+			Host:   proxyName + "." + test.ServingNamespace + ".svc." + test.NetworkingFlags.ClusterSuffix,
+			Port:   int32(proxyPort),
+			Scheme: "http",
+		})
+	})
+}
+
+func testExternalBackend(ctx context.Context, t *testing.T, clients *test.Clients, external *v1alpha1.ExternalBackend) {
+	t.Helper()
+
+	// Using fixed hostnames can lead to conflicts when -count=N>1
+	// so pseudo-randomize the hostnames to avoid conflicts.
+	publicHostName := test.ObjectNameForTest(t) + ".external." + test.NetworkingFlags.ServiceDomain
+
+	_, client, _ := CreateIngressReady(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{publicHostName},
+			Visibility: v1alpha1.IngressVisibilityExternalIP,
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					Splits: []v1alpha1.IngressBackendSplit{{
+						IngressBackend: v1alpha1.IngressBackend{
+							External: external,
+						},
+					}},
+				}},
+			},
+		}},
+	})
+
+	RuntimeRequest(ctx, t, client, "http://"+publicHostName)
+}
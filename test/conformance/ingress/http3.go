@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/networking/pkg/apis/networking"
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/test"
+	"knative.dev/pkg/reconciler"
+	pkgTest "knative.dev/pkg/test"
+)
+
+// TestHTTP3 verifies that an Ingress with HTTP/3 enabled advertises it through
+// the Alt-Svc header of its HTTPS responses, and serves HTTP/3 requests.
+func TestHTTP3(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	name, port, _ := CreateRuntimeService(ctx, t, clients, networking.ServicePortNameHTTP1)
+
+	host := name + "." + test.NetworkingFlags.ServiceDomain
+	secretName, tlsConfig, _ := CreateTLSSecret(ctx, t, clients, []string{host})
+
+	ing, client, _ := CreateIngressReadyWithTLS(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{host},
+			Visibility: v1alpha1.IngressVisibilityExternalIP,
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					Splits: []v1alpha1.IngressBackendSplit{{
+						IngressBackend: v1alpha1.IngressBackend{
+							ServiceName:      name,
+							ServiceNamespace: test.ServingNamespace,
+							ServicePort:      intstr.FromInt(port),
+						},
+					}},
+				}},
+			},
+		}},
+		TLS: []v1alpha1.IngressTLS{{
+			Hosts:           []string{host},
+			SecretName:      secretName,
+			SecretNamespace: test.ServingNamespace,
+		}},
+		HTTP3: v1alpha1.HTTP3OptionEnabled,
+	}, tlsConfig)
+
+	var altSvc string
+	RuntimeRequestWithExpectations(ctx, t, client, "https://"+host,
+		[]ResponseExpectation{
+			StatusCodeExpectation(sets.New(http.StatusOK)),
+			func(resp *http.Response) error {
+				altSvc = resp.Header.Get("Alt-Svc")
+				return nil
+			},
+		},
+		false)
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	h3Port, ok := altSvcHTTP3Port(altSvc)
+	if !ok {
+		t.Fatalf("Alt-Svc = %q, wanted an h3 alternative", altSvc)
+	}
+
+	address := quicAddress(ctx, t, ing, clients, h3Port)
+	h3Client := &http.Client{
+		Transport: &uaRoundTripper{
+			RoundTripper: &http3.Transport{
+				TLSClientConfig: &tls.Config{
+					RootCAs:    tlsConfig.RootCAs,
+					MinVersion: tls.VersionTLS13,
+				},
+				Dial: func(ctx context.Context, _ string, tlsCfg *tls.Config, cfg *quic.Config) (*quic.Conn, error) {
+					return quic.DialAddrEarly(ctx, address, tlsCfg, cfg)
+				},
+			},
+			ua: fmt.Sprintf("knative.dev/%s/%s", t.Name(), ing.Name),
+		},
+	}
+	RuntimeRequestWithExpectations(ctx, t, h3Client, "https://"+host,
+		[]ResponseExpectation{
+			StatusCodeExpectation(sets.New(http.StatusOK)),
+			func(resp *http.Response) error {
+				if resp.ProtoMajor != 3 {
+					return fmt.Errorf("got protocol %s, wanted HTTP/3", resp.Proto)
+				}
+				return nil
+			},
+		},
+		false)
+}
+
+// altSvcHTTP3Port returns the port of the HTTP/3 alternative advertised in
+// the given Alt-Svc header value, e.g. `h3=":443"; ma=86400`.
+func altSvcHTTP3Port(altSvc string) (int32, bool) {
+	for _, alt := range strings.Split(altSvc, ",") {
+		protocol, authority, ok := strings.Cut(strings.TrimSpace(alt), "=")
+		if !ok || protocol != "h3" {
+			continue
+		}
+		authority, _, _ = strings.Cut(authority, ";")
+		_, port, err := net.SplitHostPort(strings.Trim(authority, `"`))
+		if err != nil {
+			continue
+		}
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			continue
+		}
+		return int32(p), true
+	}
+	return 0, false
+}
+
+// quicAddress looks up the UDP address serving the given port of the provided
+// Ingress' public load balancer, similarly to CreateDialContext.
+func quicAddress(ctx context.Context, t *testing.T, ing *v1alpha1.Ingress, clients *test.Clients, port int32) string {
+	t.Helper()
+	if ing.Status.PublicLoadBalancer == nil || len(ing.Status.PublicLoadBalancer.Ingress) < 1 {
+		t.Fatal("Ingress does not have a public load balancer assigned.")
+	}
+
+	lb := ing.Status.PublicLoadBalancer.Ingress[0]
+	if lb.DomainInternal != "" {
+		parts := strings.SplitN(lb.DomainInternal, ".", 3)
+		if len(parts) < 3 {
+			t.Fatal("Too few parts in internal domain:", lb.DomainInternal)
+		}
+		name, namespace := parts[0], parts[1]
+
+		var svc *corev1.Service
+		err := reconciler.RetryTestErrors(func(attempts int) (err error) {
+			svc, err = clients.KubeClient.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+			return err
+		})
+		if err != nil {
+			t.Fatalf("Unable to retrieve Kubernetes service %s/%s: %v", namespace, name, err)
+		}
+
+		if pkgTest.Flags.IngressEndpoint != "" {
+			for _, sp := range svc.Spec.Ports {
+				if sp.Protocol == corev1.ProtocolUDP && sp.Port == port {
+					return net.JoinHostPort(pkgTest.Flags.IngressEndpoint, strconv.Itoa(int(sp.NodePort)))
+				}
+			}
+			t.Fatal("Service doesn't contain a matching UDP port:", port)
+		} else if len(svc.Status.LoadBalancer.Ingress) >= 1 {
+			ingress := svc.Status.LoadBalancer.Ingress[0]
+			if ingress.IP != "" {
+				return net.JoinHostPort(ingress.IP, strconv.Itoa(int(port)))
+			}
+			if ingress.Hostname != "" {
+				return net.JoinHostPort(ingress.Hostname, strconv.Itoa(int(port)))
+			}
+			t.Fatal("Service ingress does not contain dialing information.")
+		}
+		t.Fatal("Service does not have a supported shape (not type LoadBalancer? missing --ingressendpoint?).")
+	}
+
+	if len(lb.IPs) > 0 {
+		return net.JoinHostPort(lb.IPs[0], strconv.Itoa(int(port)))
+	}
+	if lb.IP != "" {
+		return net.JoinHostPort(lb.IP, strconv.Itoa(int(port)))
+	}
+	t.Fatal("No IP or domain found on ingress.")
+	return "" // Unreachable
+}
@@ -18,10 +18,15 @@ package ingress
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/networking/pkg/apis/networking"
 	"knative.dev/networking/pkg/apis/networking/v1alpha1"
 	"knative.dev/networking/test"
@@ -32,6 +37,7 @@ import (
 // the client must be disinguished.
 type testClient struct {
 	code   int
+	hsts   *v1alpha1.HSTSPolicy
 	client *http.Client
 }
 
@@ -42,6 +48,7 @@ func TestHTTPOption(t *testing.T) {
 
 	tests := []struct {
 		httpOption v1alpha1.HTTPOption
+		hsts       *v1alpha1.HSTSPolicy
 		code       int
 	}{{
 		httpOption: v1alpha1.HTTPOptionEnabled,
@@ -49,14 +56,21 @@ func TestHTTPOption(t *testing.T) {
 	}, {
 		httpOption: v1alpha1.HTTPOptionRedirected,
 		code:       http.StatusMovedPermanently,
+	}, {
+		httpOption: v1alpha1.HTTPOptionRedirected,
+		hsts: &v1alpha1.HSTSPolicy{
+			MaxAge:            300,
+			IncludeSubDomains: true,
+		},
+		code: http.StatusMovedPermanently,
 	}}
 
 	hostCode := make(map[string]testClient, len(tests))
 	// Create multiple ingress with different HTTP option at the same time.
 	// This makes sure that each Ingress's HTTP option does not effect on globally.
 	for _, test := range tests {
-		host, client := create(ctx, t, clients, test.httpOption)
-		hostCode[host] = testClient{code: test.code, client: client}
+		host, client := create(ctx, t, clients, test.httpOption, test.hsts)
+		hostCode[host] = testClient{code: test.code, hsts: test.hsts, client: client}
 	}
 
 	// Request to each Ingress.
@@ -65,7 +79,7 @@ func TestHTTPOption(t *testing.T) {
 	}
 }
 
-func create(ctx context.Context, t *testing.T, clients *test.Clients, httpOption v1alpha1.HTTPOption) (string, *http.Client) {
+func create(ctx context.Context, t *testing.T, clients *test.Clients, httpOption v1alpha1.HTTPOption, hsts *v1alpha1.HSTSPolicy) (string, *http.Client) {
 	name, port, _ := CreateRuntimeService(ctx, t, clients, networking.ServicePortNameHTTP1)
 
 	hosts := []string{name + "." + test.NetworkingFlags.ServiceDomain}
@@ -74,6 +88,7 @@ func create(ctx context.Context, t *testing.T, clients *test.Clients, httpOption
 
 	_, client, _ := CreateIngressReadyWithTLS(ctx, t, clients, v1alpha1.IngressSpec{
 		HTTPOption: httpOption,
+		HSTS:       hsts,
 		Rules: []v1alpha1.IngressRule{{
 			Hosts:      hosts,
 			Visibility: v1alpha1.IngressVisibilityExternalIP,
@@ -99,8 +114,13 @@ func create(ctx context.Context, t *testing.T, clients *test.Clients, httpOption
 }
 
 func checkHTTPOption(ctx context.Context, t *testing.T, hostname string, c testClient) {
-	// Check with TLS.
-	RuntimeRequest(ctx, t, c.client, "https://"+hostname)
+	// Check with TLS. The HSTS policy is only checked if the Ingress sets one,
+	// as the cluster may advertise a default policy otherwise.
+	expectations := []ResponseExpectation{StatusCodeExpectation(sets.New(http.StatusOK))}
+	if c.hsts != nil {
+		expectations = append(expectations, hstsExpectation(c.hsts))
+	}
+	RuntimeRequestWithExpectations(ctx, t, c.client, "https://"+hostname, expectations, false)
 
 	// Check without TLS.
 	c.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
@@ -117,4 +137,53 @@ func checkHTTPOption(ctx context.Context, t *testing.T, hostname string, c testC
 		t.Errorf("Unexpected status code: %d, wanted %v", resp.StatusCode, c.code)
 		DumpResponse(ctx, t, resp)
 	}
+	// The HSTS policy of the Ingress must not be advertised over plain HTTP.
+	if got := resp.Header.Get("Strict-Transport-Security"); c.hsts != nil && got != "" {
+		t.Errorf("Got Strict-Transport-Security header %q over HTTP, wanted none", got)
+	}
+}
+
+// hstsExpectation checks that the Strict-Transport-Security header of the
+// response advertises the directives of the given policy.
+func hstsExpectation(want *v1alpha1.HSTSPolicy) ResponseExpectation {
+	return func(resp *http.Response) error {
+		value := resp.Header.Get("Strict-Transport-Security")
+		got, err := parseHSTS(value)
+		if err != nil {
+			return fmt.Errorf("failed to parse Strict-Transport-Security header %q: %w", value, err)
+		}
+		if *got != *want {
+			return fmt.Errorf("got Strict-Transport-Security header %q, wanted directives %+v", value, *want)
+		}
+		return nil
+	}
+}
+
+// parseHSTS parses the directives of a Strict-Transport-Security header value,
+// which are case-insensitive and may come in any order (RFC 6797 section 6.1).
+func parseHSTS(value string) (*v1alpha1.HSTSPolicy, error) {
+	policy := &v1alpha1.HSTSPolicy{}
+	hasMaxAge := false
+	for _, directive := range strings.Split(value, ";") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "":
+			// Empty directives are allowed.
+		case "max-age":
+			maxAge, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(arg), `"`), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid max-age: %w", err)
+			}
+			policy.MaxAge = maxAge
+			hasMaxAge = true
+		case "includesubdomains":
+			policy.IncludeSubDomains = true
+		case "preload":
+			policy.Preload = true
+		}
+	}
+	if !hasMaxAge {
+		return nil, errors.New("no max-age directive")
+	}
+	return policy, nil
 }
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/networking/pkg/apis/networking"
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/test"
+	"knative.dev/pkg/network"
+)
+
+// TestLoadBalancerAddresses verifies that every address advertised in the public
+// load balancer status of an Ingress serves the Ingress on its HTTP port.
+func TestLoadBalancerAddresses(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	name, port, _ := CreateRuntimeService(ctx, t, clients, networking.ServicePortNameHTTP1)
+
+	host := name + "." + test.NetworkingFlags.ServiceDomain
+	ing, _, _ := CreateIngressReady(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{host},
+			Visibility: v1alpha1.IngressVisibilityExternalIP,
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					Splits: []v1alpha1.IngressBackendSplit{{
+						IngressBackend: v1alpha1.IngressBackend{
+							ServiceName:      name,
+							ServiceNamespace: test.ServingNamespace,
+							ServicePort:      intstr.FromInt(port),
+						},
+					}},
+				}},
+			},
+		}},
+	})
+
+	dialed := 0
+	for _, lb := range ing.Status.PublicLoadBalancer.Ingress {
+		// Only the HTTP listener is dialed, the other advertised ports may
+		// serve TLS or not even be TCP.
+		port := loadBalancerPort(t, lb, 80)
+		for _, ip := range lb.IPs {
+			address := net.JoinHostPort(ip, strconv.Itoa(int(port)))
+			t.Run(address, func(t *testing.T) {
+				RuntimeRequest(ctx, t, addressClient(t, ing, address), "http://"+host)
+			})
+			dialed++
+		}
+	}
+	if dialed == 0 {
+		t.Skip("The Ingress doesn't advertise any IP in its public load balancer status.")
+	}
+}
+
+// addressClient returns an HTTP client that sends all of its requests to the given address.
+func addressClient(t *testing.T, ing *v1alpha1.Ingress, address string) *http.Client {
+	dial := network.NewBackoffDialer(dialBackoff)
+	return &http.Client{
+		Transport: &uaRoundTripper{
+			RoundTripper: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dial(ctx, "tcp", address)
+				},
+			},
+			ua: fmt.Sprintf("knative.dev/%s/%s", t.Name(), ing.Name),
+		},
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"net"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/test"
+)
+
+// TestTLSPassthrough verifies that a TLSPassthrough rule forwards TLS
+// connections to the backend matching their SNI, without terminating them.
+func TestTLSPassthrough(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	host := test.ObjectNameForTest(t) + "." + test.NetworkingFlags.ServiceDomain
+	secretName, tlsConfig, _ := CreateTLSSecret(ctx, t, clients, []string{host})
+	name, port, _ := CreateTCPEchoService(ctx, t, clients, secretName)
+
+	_, dialCtx, _ := createIngressReadyDialContext(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{host},
+			Visibility: v1alpha1.IngressVisibilityExternalIP,
+			TLSPassthrough: &v1alpha1.TLSPassthroughRuleValue{
+				Backend: v1alpha1.IngressBackend{
+					ServiceName:      name,
+					ServiceNamespace: test.ServingNamespace,
+					ServicePort:      intstr.FromInt(port),
+				},
+			},
+		}},
+	})
+
+	rawConn, err := dialCtx(ctx, "tcp", net.JoinHostPort(host, "443"))
+	if err != nil {
+		t.Fatal("Error dialing the Ingress:", err)
+	}
+	// The handshake only succeeds if the backend, which is the only one
+	// holding the key of the certificate, terminates the connection.
+	conn := tls.Client(rawConn, &tls.Config{
+		ServerName: host,
+		RootCAs:    tlsConfig.RootCAs,
+		MinVersion: tls.VersionTLS12,
+	})
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(time.Minute))
+	if err := conn.HandshakeContext(ctx); err != nil {
+		t.Fatal("Error during the TLS handshake:", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	for _, want := range []string{"hello", name} {
+		if _, err := conn.Write([]byte(want + "\n")); err != nil {
+			t.Fatal("Error writing message:", err)
+		}
+		got, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal("Error reading message:", err)
+		}
+		if got := got[:len(got)-1]; got != want {
+			t.Errorf("Echo = %q, wanted %q", got, want)
+		}
+	}
+}
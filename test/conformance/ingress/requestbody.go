@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/networking/pkg/apis/networking"
+	"knative.dev/networking/pkg/apis/networking/v1alpha1"
+	"knative.dev/networking/test"
+	"knative.dev/pkg/ptr"
+)
+
+// TestRequestBodyLimit verifies that an Ingress rejects the requests whose body
+// exceeds the limit of their path, or of their rule, with a 413.
+func TestRequestBodyLimit(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	name, port, _ := CreateRuntimeService(ctx, t, clients, networking.ServicePortNameHTTP1)
+
+	backend := []v1alpha1.IngressBackendSplit{{
+		IngressBackend: v1alpha1.IngressBackend{
+			ServiceName:      name,
+			ServiceNamespace: test.ServingNamespace,
+			ServicePort:      intstr.FromInt(port),
+		},
+	}}
+
+	const ruleLimit, pathLimit = 1024, 4096
+
+	host := name + "." + test.NetworkingFlags.ServiceDomain
+	_, client, _ := CreateIngressReady(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{host},
+			Visibility: v1alpha1.IngressVisibilityExternalIP,
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					Path:                "/buffered",
+					Splits:              backend,
+					MaxRequestBodyBytes: ptr.Int64(pathLimit),
+					RequestBuffering:    v1alpha1.RequestBufferingBuffered,
+				}, {
+					Splits: backend,
+				}},
+				Options: &v1alpha1.HTTPIngressRuleOptions{
+					MaxRequestBodyBytes: ptr.Int64(ruleLimit),
+				},
+			},
+		}},
+	})
+
+	tests := []struct {
+		name string
+		path string
+		size int
+		code int
+	}{{
+		name: "body within the rule limit",
+		path: "/",
+		size: ruleLimit,
+		code: http.StatusOK,
+	}, {
+		name: "body over the rule limit",
+		path: "/",
+		size: ruleLimit + 1,
+		code: http.StatusRequestEntityTooLarge,
+	}, {
+		name: "body within the path limit",
+		path: "/buffered",
+		size: pathLimit,
+		code: http.StatusOK,
+	}, {
+		name: "body over the path limit",
+		path: "/buffered",
+		size: pathLimit + 1,
+		code: http.StatusRequestEntityTooLarge,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := client.Post("http://"+host+test.path, "application/octet-stream",
+				bytes.NewReader(bytes.Repeat([]byte("a"), test.size)))
+			if err != nil {
+				t.Fatal("Error making POST request:", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != test.code {
+				t.Errorf("Unexpected status code: %d, wanted %d", resp.StatusCode, test.code)
+				DumpResponse(ctx, t, resp)
+			}
+		})
+	}
+}
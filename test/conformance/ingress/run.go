@@ -56,7 +56,16 @@ var betaTests = map[string]func(t *testing.T){
 
 var alphaTests = map[string]func(t *testing.T){
 	// Add your conformance test for alpha features
-	"httpoption": TestHTTPOption,
+	"httpoption":             TestHTTPOption,
+	"backend/external":       TestExternalBackend,
+	"visibility/internal":    TestVisibilityInternal,
+	"loadbalancer/addresses": TestLoadBalancerAddresses,
+	"tls/passthrough":        TestTLSPassthrough,
+	"http3":                  TestHTTP3,
+	"requestbody/limit":      TestRequestBodyLimit,
+	"compression":            TestCompression,
+	"errorpages":             TestErrorPages,
+	"websocket/timeouts":     TestWebsocketTimeouts,
 }
 
 // RunConformance will run ingress conformance tests
@@ -405,6 +405,8 @@ func CreateTimeoutService(ctx context.Context, t *testing.T, clients *test.Clien
 
 // CreateWebsocketService creates a Kubernetes service that will upgrade the connection
 // to use websockets and echo back the received messages with the provided suffix.
+// Setting the `tick` query parameter of the upgrade request to a duration, e.g. "500ms",
+// makes the service write messages of its own at that interval as well.
 func CreateWebsocketService(ctx context.Context, t *testing.T, clients *test.Clients, suffix string) (string, int, context.CancelFunc) {
 	t.Helper()
 	name := test.ObjectNameForTest(t)
@@ -491,6 +493,88 @@ func CreateWebsocketService(ctx context.Context, t *testing.T, clients *test.Cli
 	return name, port, createPodAndService(ctx, t, clients, pod, svc)
 }
 
+// CreateTCPEchoService creates a Kubernetes service terminating TLS with the
+// certificate of the given secret, and echoing back every line received on the
+// connection. It returns the service name, the port on which the service is
+// listening, and a "cancel" function to clean up the created resources.
+func CreateTCPEchoService(ctx context.Context, t *testing.T, clients *test.Clients, secretName string) (string, int, context.CancelFunc) {
+	t.Helper()
+	name := test.ObjectNameForTest(t)
+
+	// Avoid zero, but pick a low port number.
+	port := 50 + rand.Intn(50)
+	t.Logf("[%s] Using port %d", name, port)
+
+	// Pick a high port number.
+	containerPort := 8000 + rand.Intn(100)
+	t.Logf("[%s] Using containerPort %d", name, containerPort)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: test.ServingNamespace,
+			Labels: map[string]string{
+				"test-pod": name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:            "foo",
+				Image:           pkgTest.ImagePath("tcpecho"),
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Ports: []corev1.ContainerPort{{
+					Name:          "tls",
+					ContainerPort: int32(containerPort),
+				}},
+				// This is needed by the tcpecho image we are using.
+				Env: []corev1.EnvVar{{
+					Name:  "PORT",
+					Value: strconv.Itoa(containerPort),
+				}},
+				ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{
+						TCPSocket: &corev1.TCPSocketAction{
+							Port: intstr.FromInt(containerPort),
+						},
+					},
+				},
+			}},
+		},
+	}
+	pod = PodWithOption(pod,
+		WithEnv([]corev1.EnvVar{{Name: "CERT", Value: certPath}, {Name: "KEY", Value: keyPath}}...),
+		WithVolume("knative-certs", certDirectory, corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+				Optional:   ptr.Bool(false),
+			},
+		}),
+	)
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: test.ServingNamespace,
+			Labels: map[string]string{
+				"test-pod": name,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: "ClusterIP",
+			Ports: []corev1.ServicePort{{
+				Name:       "tls",
+				Port:       int32(port),
+				TargetPort: intstr.FromInt(containerPort),
+			}},
+			Selector: map[string]string{
+				"test-pod": name,
+			},
+		},
+	}
+
+	return name, port, createPodAndService(ctx, t, clients, pod, svc)
+}
+
 // CreateGRPCService creates a Kubernetes service that will upgrade the connection
 // to use GRPC and echo back the received messages with the provided suffix.
 func CreateGRPCService(ctx context.Context, t *testing.T, clients *test.Clients, suffix string) (string, int, context.CancelFunc) {
@@ -1104,17 +1188,37 @@ func CreateDialContext(ctx context.Context, t *testing.T, ing *v1alpha1.Ingress,
 			}
 		}
 		t.Fatal("Service does not have a supported shape (not type LoadBalancer? missing --ingressendpoint?).")
-	} else if ing.Status.PublicLoadBalancer.Ingress[0].IP != "" {
+	} else if lb := ing.Status.PublicLoadBalancer.Ingress[0]; lb.IP != "" || len(lb.IPs) > 0 {
 		dial := network.NewBackoffDialer(dialBackoff)
-		ingressIP := ing.Status.PublicLoadBalancer.Ingress[0].IP
 
-		port := 80
-		if ing.Spec.Rules[0].Visibility == v1alpha1.IngressVisibilityExternalIP && ing.Spec.HTTPOption == v1alpha1.HTTPOptionRedirected {
+		port := int32(80)
+		if rule := ing.Spec.Rules[0]; rule.TLSPassthrough != nil {
+			// TLS passthrough rules are served on the TLS listener.
+			port = 443
+			if rule.Port != 0 {
+				port = rule.Port
+			}
+		} else if rule.Visibility == v1alpha1.IngressVisibilityExternalIP && ing.Spec.HTTPOption == v1alpha1.HTTPOptionRedirected {
 			port = 443
 		}
+		port = loadBalancerPort(t, lb, port)
 
+		// Dual-stack load balancers advertise several addresses, use the first one
+		// we manage to connect to.
+		addresses := lb.IPs
+		if len(addresses) == 0 {
+			addresses = []string{lb.IP}
+		}
 		return func(ctx context.Context, _ string, _ string) (net.Conn, error) {
-			return dial(ctx, "tcp", fmt.Sprintf("%s:%d", ingressIP, port))
+			var errs []error
+			for _, ip := range addresses {
+				conn, err := dial(ctx, "tcp", net.JoinHostPort(ip, strconv.Itoa(int(port))))
+				if err == nil {
+					return conn, nil
+				}
+				errs = append(errs, err)
+			}
+			return nil, errors.Join(errs...)
 		}
 	} else {
 		t.Fatal("No IP or domain found on ingress.")
@@ -1122,6 +1226,23 @@ func CreateDialContext(ctx context.Context, t *testing.T, ing *v1alpha1.Ingress,
 	return nil // Unreachable
 }
 
+// loadBalancerPort returns the given port of the load balancer ingress point, failing
+// the test if the load balancer advertises its ports but not that one over TCP. Load
+// balancers not advertising any port are assumed to listen on the default ones.
+func loadBalancerPort(t *testing.T, lb v1alpha1.LoadBalancerIngressStatus, port int32) int32 {
+	t.Helper()
+	if len(lb.Ports) == 0 {
+		return port
+	}
+	for _, p := range lb.Ports {
+		if p.Port == port && (p.Protocol == "" || p.Protocol == corev1.ProtocolTCP) {
+			return port
+		}
+	}
+	t.Fatalf("Load balancer %s doesn't advertise the TCP port %d: %v", lb.IP, port, lb.Ports)
+	return 0 // Unreachable
+}
+
 type (
 	RequestOption       func(*http.Request)
 	ResponseExpectation func(response *http.Response) error
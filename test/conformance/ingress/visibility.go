@@ -80,7 +80,13 @@ func TestVisibility(t *testing.T) {
 
 func testProxyToHelloworld(ctx context.Context, t *testing.T, ingress *v1alpha1.Ingress, clients *test.Clients, privateHostName string) {
 	loadbalancerAddress := ingress.Status.PrivateLoadBalancer.Ingress[0].DomainInternal
-	proxyName, proxyPort, _ := CreateProxyService(ctx, t, clients, privateHostName, loadbalancerAddress)
+	testProxyToHost(ctx, t, clients, privateHostName, loadbalancerAddress)
+}
+
+// testProxyToHost exposes the given host served by the given load balancer
+// publicly through a proxy, and checks that it is reachable that way.
+func testProxyToHost(ctx context.Context, t *testing.T, clients *test.Clients, host, loadbalancerAddress string) {
+	proxyName, proxyPort, _ := CreateProxyService(ctx, t, clients, host, loadbalancerAddress)
 
 	// Using fixed hostnames can lead to conflicts when -count=N>1
 	// so pseudo-randomize the hostnames to avoid conflicts.
@@ -108,6 +114,50 @@ func testProxyToHelloworld(ctx context.Context, t *testing.T, ingress *v1alpha1.
 	RuntimeRequest(ctx, t, client, "http://"+publicHostName)
 }
 
+// TestVisibilityInternal verifies that rules with InternalIP visibility are
+// not exposed through the public load balancer, but are served by the
+// internal load balancer.
+func TestVisibilityInternal(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	name, port, _ := CreateRuntimeService(ctx, t, clients, networking.ServicePortNameHTTP1)
+
+	// Using fixed hostnames can lead to conflicts when -count=N>1
+	// so pseudo-randomize the hostnames to avoid conflicts.
+	internalHostName := test.ObjectNameForTest(t) + ".internal." + test.NetworkingFlags.ServiceDomain
+	ingress, client, _ := CreateIngressReady(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{internalHostName},
+			Visibility: v1alpha1.IngressVisibilityInternalIP,
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					Splits: []v1alpha1.IngressBackendSplit{{
+						IngressBackend: v1alpha1.IngressBackend{
+							ServiceName:      name,
+							ServiceNamespace: test.ServingNamespace,
+							ServicePort:      intstr.FromInt(port),
+						},
+					}},
+				}},
+			},
+		}},
+	})
+
+	// Ensure the service is not publicly accessible
+	RuntimeRequestWithExpectations(ctx, t, client, "http://"+internalHostName, []ResponseExpectation{StatusCodeExpectation(sets.New(http.StatusNotFound))}, true)
+
+	if ingress.Status.InternalLoadBalancer == nil || len(ingress.Status.InternalLoadBalancer.Ingress) < 1 {
+		t.Fatal("Ingress does not have an internal load balancer assigned.")
+	}
+	lb := ingress.Status.InternalLoadBalancer.Ingress[0]
+	loadbalancerAddress := lb.DomainInternal
+	if loadbalancerAddress == "" {
+		loadbalancerAddress = lb.IP
+	}
+	testProxyToHost(ctx, t, clients, internalHostName, loadbalancerAddress)
+}
+
 func TestVisibilitySplit(t *testing.T) {
 	t.Parallel()
 	ctx, clients := context.Background(), test.Setup(t)
@@ -28,6 +28,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/gorilla/websocket"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/networking/pkg/apis/networking/v1alpha1"
@@ -162,6 +163,113 @@ func TestWebsocketSplit(t *testing.T) {
 	t.Errorf("(over %d requests) (-want, +got) = %s", maxRequests, cmp.Diff(sets.List(want), sets.List(got)))
 }
 
+// TestWebsocketTimeouts verifies that an Ingress closes the websockets going idle
+// for longer than the idle timeout of their path, and the active ones lasting
+// longer than its max stream duration.
+func TestWebsocketTimeouts(t *testing.T) {
+	t.Parallel()
+	ctx, clients := context.Background(), test.Setup(t)
+
+	const suffix = "- pong"
+	name, port, _ := CreateWebsocketService(ctx, t, clients, suffix)
+
+	const (
+		idleTimeout       = 3 * time.Second
+		maxStreamDuration = 10 * time.Second
+		// Leeway for the Ingress to act on the timeouts.
+		slack = 5 * time.Second
+	)
+
+	domain := name + "." + test.NetworkingFlags.ServiceDomain
+	_, dialCtx, _ := createIngressReadyDialContext(ctx, t, clients, v1alpha1.IngressSpec{
+		Rules: []v1alpha1.IngressRule{{
+			Hosts:      []string{domain},
+			Visibility: v1alpha1.IngressVisibilityExternalIP,
+			HTTP: &v1alpha1.HTTPIngressRuleValue{
+				Paths: []v1alpha1.HTTPIngressPath{{
+					Splits: []v1alpha1.IngressBackendSplit{{
+						IngressBackend: v1alpha1.IngressBackend{
+							ServiceName:      name,
+							ServiceNamespace: test.ServingNamespace,
+							ServicePort:      intstr.FromInt(port),
+						},
+					}},
+					IdleTimeout:       &metav1.Duration{Duration: idleTimeout},
+					MaxStreamDuration: &metav1.Duration{Duration: maxStreamDuration},
+				}},
+			},
+		}},
+	})
+
+	dialer := websocket.Dialer{
+		NetDialContext:   dialCtx,
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 45 * time.Second,
+	}
+
+	tests := []struct {
+		name string
+		// tick is the interval at which the server writes messages, none if empty.
+		tick     string
+		min, max time.Duration
+	}{{
+		name: "idle connection",
+		min:  idleTimeout,
+		max:  idleTimeout + slack,
+	}, {
+		name: "active connection",
+		tick: "500ms",
+		min:  maxStreamDuration,
+		max:  maxStreamDuration + slack,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			u := url.URL{Scheme: "ws", Host: domain, Path: "/"}
+			if test.tick != "" {
+				u.RawQuery = url.Values{"tick": {test.tick}}.Encode()
+			}
+			start := time.Now()
+			//nolint:bodyclose
+			conn, _, err := dialer.Dial(u.String(), http.Header{"Host": {domain}})
+			if err != nil {
+				t.Fatal("Dial() =", err)
+			}
+			defer conn.Close()
+
+			if test.tick == "" {
+				// The connection is usable until it goes idle.
+				checkWebsocketRoundTrip(t, conn, suffix)
+			}
+
+			// Read the messages of the server, if any, until the connection is closed.
+			if err := conn.SetReadDeadline(start.Add(test.max)); err != nil {
+				t.Fatal("SetReadDeadline() =", err)
+			}
+			ticks := 0
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					break
+				}
+				ticks++
+			}
+			elapsed := time.Since(start)
+
+			if test.tick != "" && ticks == 0 {
+				t.Error("Didn't receive any message from the server")
+			}
+
+			if elapsed >= test.max {
+				t.Errorf("Connection still open after %v, wanted it closed within %v", elapsed, test.max)
+			} else if elapsed < test.min {
+				t.Errorf("Connection closed after %v, wanted it open for at least %v", elapsed, test.min)
+			}
+		})
+	}
+}
+
 func findWebsocketSuffix(t *testing.T, conn *websocket.Conn) string {
 	t.Helper()
 	// Establish the suffix that corresponds to this socket.
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"log"
+	"net"
+	"os"
+
+	"knative.dev/pkg/signals"
+)
+
+// echo writes back every line received on the connection,
+// until the client closes the connection.
+func echo(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		message := scanner.Text()
+		log.Printf("Successfully received: %q", message)
+		if _, err := conn.Write([]byte(message + "\n")); err != nil {
+			log.Println("Failed to write message:", err)
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Println("Connection closed on error:", err)
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	port := os.Getenv("PORT")
+
+	var (
+		ln  net.Listener
+		err error
+	)
+	if cert, key := os.Getenv("CERT"), os.Getenv("KEY"); cert != "" && key != "" {
+		var pair tls.Certificate
+		pair, err = tls.LoadX509KeyPair(cert, key)
+		if err != nil {
+			log.Fatal("Failed to load the key pair: ", err)
+		}
+		log.Printf("Server starting on port %s with TLS", port)
+		ln, err = tls.Listen("tcp", ":"+port, &tls.Config{
+			Certificates: []tls.Certificate{pair},
+			MinVersion:   tls.VersionTLS12,
+		})
+		if err != nil {
+			log.Fatal("Failed to listen: ", err)
+		}
+	} else {
+		log.Print("Server starting on port ", port)
+		ln, err = net.Listen("tcp", ":"+port)
+		if err != nil {
+			log.Fatal("Failed to listen: ", err)
+		}
+	}
+
+	go func() {
+		<-signals.SetupSignalHandler()
+		ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		} else if err != nil {
+			log.Println("Failed to accept connection:", err)
+			continue
+		}
+		go echo(conn)
+	}
+}
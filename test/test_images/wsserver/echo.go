@@ -21,6 +21,8 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"knative.dev/networking/pkg/http/header"
@@ -28,7 +30,13 @@ import (
 	"knative.dev/networking/test"
 )
 
-const suffixMessageEnv = "SUFFIX"
+const (
+	suffixMessageEnv = "SUFFIX"
+
+	// tickQueryParam is the query parameter of the upgrade request setting the
+	// interval at which the server writes messages of its own, e.g. "500ms".
+	tickQueryParam = "tick"
+)
 
 // Gets the message suffix from envvar. Empty by default.
 func messageSuffix() string {
@@ -57,6 +65,16 @@ func handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	defer conn.Close()
+
+	// Guards the writes to the connection, which may happen concurrently
+	// when ticking.
+	var mu sync.Mutex
+	if interval, err := time.ParseDuration(r.URL.Query().Get(tickQueryParam)); err == nil && interval > 0 {
+		done := make(chan struct{})
+		defer close(done)
+		go tick(conn, &mu, interval, done)
+	}
+
 	log.Println("Connection upgraded to WebSocket. Entering receive loop.")
 	for {
 		messageType, message, err := conn.ReadMessage()
@@ -76,7 +94,10 @@ func handler(w http.ResponseWriter, r *http.Request) {
 		}
 
 		log.Printf("Successfully received: %q", message)
-		if err = conn.WriteMessage(messageType, message); err != nil {
+		mu.Lock()
+		err = conn.WriteMessage(messageType, message)
+		mu.Unlock()
+		if err != nil {
 			log.Println("Failed to write message:", err)
 			return
 		}
@@ -84,6 +105,27 @@ func handler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// tick writes a message to the connection at the given interval until done is
+// closed, so that the connection never goes idle.
+func tick(conn *websocket.Conn, mu *sync.Mutex, interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case t := <-ticker.C:
+			mu.Lock()
+			err := conn.WriteMessage(websocket.TextMessage, []byte("tick "+t.Format(time.RFC3339Nano)))
+			mu.Unlock()
+			if err != nil {
+				log.Println("Failed to write tick:", err)
+				return
+			}
+		}
+	}
+}
+
 func main() {
 	flag.Parse()
 	log.SetFlags(0)